@@ -0,0 +1,273 @@
+package object
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// groupKey - map key for the value: strings are kept as is,
+// numbers are exact decimals, other values are their canonical json.
+func groupKey(o Object) string {
+	if !o.IsExists() {
+		return ""
+	}
+	v := unwrap(*o.val)
+	if v.Kind() == reflect.String && v.Type() != typeJsonNumber {
+		return v.String()
+	}
+	if n, ok := toNumber(v); ok {
+		return n.exact()
+	}
+	data, err := o.ToCanonicalJson()
+	if err != nil {
+		return fmt.Sprintf("%#v", o.ToValue())
+	}
+	return string(data)
+}
+
+// contentKey - exact type-tagged representation of the object to compare
+// by equality like in Equal: numbers are equal by their values without
+// float64 losses, strings aren't equal to numbers or booleans.
+func contentKey(o Object) string {
+	if !o.IsExists() {
+		return ""
+	}
+	var b strings.Builder
+	writeContentKey(&b, plain(*o.val))
+	return b.String()
+}
+
+func writeContentKey(b *strings.Builder, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case bool:
+		b.WriteString("b:" + strconv.FormatBool(v))
+	case string:
+		b.WriteString("s:" + strconv.Quote(v))
+	case []byte:
+		b.WriteString("x:" + hex.EncodeToString(v))
+	case time.Time:
+		b.WriteString("t:" + v.UTC().Format(time.RFC3339Nano))
+	case map[string]interface{}:
+		b.WriteString("{")
+		for i, key := range sortedKeys(v) {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(strconv.Quote(key) + ":")
+			writeContentKey(b, v[key])
+		}
+		b.WriteString("}")
+	case []interface{}:
+		b.WriteString("[")
+		for i, value := range v {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			writeContentKey(b, value)
+		}
+		b.WriteString("]")
+	default:
+		if n, ok := toNumber(reflect.ValueOf(v)); ok {
+			b.WriteString("n:" + n.exact())
+			return
+		}
+		fmt.Fprintf(b, "%T:%#v", v, v)
+	}
+}
+
+// GroupBy - make a map of slices of the elements grouped by their values
+// at the path (ParsePath syntax). Strings are keys as is, numbers are keys
+// as exact decimals (like "30"), other values are keys as their canonical
+// json (like "true"). Returns ErrorKeyConflict if different values have
+// same keys, like string "30" and number 30.
+// Elements without the path are skipping.
+func (o Object) GroupBy(path string) Object {
+	return o.aggregateMap(path, func(result map[string]interface{}, key string, element interface{}) {
+		group, _ := result[key].([]interface{})
+		result[key] = append(group, element)
+	})
+}
+
+// IndexBy - make a map of the elements keyed by their values at the path
+// (ParsePath syntax) like GroupBy does. Last element wins for same keys.
+func (o Object) IndexBy(path string) Object {
+	return o.aggregateMap(path, func(result map[string]interface{}, key string, element interface{}) {
+		result[key] = element
+	})
+}
+
+func (o Object) aggregateMap(path string, add func(result map[string]interface{}, key string, element interface{})) Object {
+	elements, keys, err := o.elementsAt(path)
+	if err != nil {
+		return Object{nil, err}
+	}
+	result := make(map[string]interface{})
+	contents := make(map[string]string)
+	for i, element := range elements {
+		if !keys[i].IsExists() {
+			continue
+		}
+		key, content := groupKey(keys[i]), contentKey(keys[i])
+		if existing, ok := contents[key]; ok && existing != content {
+			return Object{nil, newError(ErrorKeyConflict)}
+		}
+		contents[key] = content
+		add(result, key, element)
+	}
+	return New(result)
+}
+
+// Distinct - make a new slice without duplicates, first occurrences
+// are kept. Elements are comparing like in Equal.
+func (o Object) Distinct() Object {
+	return o.UniqBy("")
+}
+
+// UniqBy - make a new slice of elements with unique values at the path
+// (ParsePath syntax), first occurrences are kept.
+// Values are comparing like in Equal, all elements without the path
+// are considering as same.
+func (o Object) UniqBy(path string) Object {
+	elements, keys, err := o.elementsAt(path)
+	if err != nil {
+		return Object{nil, err}
+	}
+	seen := make(map[string]bool, len(elements))
+	result := make([]interface{}, 0, len(elements))
+	for i, element := range elements {
+		key := "\x00missing"
+		if keys[i].IsExists() {
+			key = contentKey(keys[i])
+		}
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, element)
+		}
+	}
+	return New(result)
+}
+
+// Count - count of elements with not null values at the path (ParsePath syntax).
+// Returns 0 for not slices.
+func (o Object) Count(path string) int {
+	_, keys, _ := o.elementsAt(path)
+	count := 0
+	for _, key := range keys {
+		if key.IsExists() && !key.IsNil() {
+			count++
+		}
+	}
+	return count
+}
+
+// Sum - sum of numbers at the path (ParsePath syntax) as float64.
+// Non-numeric values are skipping.
+func (o Object) Sum(path string) Object {
+	numbers, err := o.numbersAt(path)
+	if err != nil {
+		return Object{nil, err}
+	}
+	sum := 0.0
+	for _, n := range numbers {
+		sum += n.float
+	}
+	return New(sum)
+}
+
+// Avg - arithmetic mean of numbers at the path (ParsePath syntax) as float64.
+// Non-numeric values are skipping. Returns ErrorElementNotFound
+// if there are no numbers.
+func (o Object) Avg(path string) Object {
+	numbers, err := o.numbersAt(path)
+	if err != nil {
+		return Object{nil, err}
+	}
+	if len(numbers) == 0 {
+		return Object{nil, newError(ErrorElementNotFound)}
+	}
+	sum := 0.0
+	for _, n := range numbers {
+		sum += n.float
+	}
+	return New(sum / float64(len(numbers)))
+}
+
+// Min - the least number at the path (ParsePath syntax) as is.
+// Non-numeric values are skipping. Returns ErrorElementNotFound
+// if there are no numbers.
+func (o Object) Min(path string) Object {
+	return o.extremum(path, -1)
+}
+
+// Max - the greatest number at the path (ParsePath syntax) as is.
+// Non-numeric values are skipping. Returns ErrorElementNotFound
+// if there are no numbers.
+func (o Object) Max(path string) Object {
+	return o.extremum(path, 1)
+}
+
+func (o Object) extremum(path string, sign int) Object {
+	_, keys, err := o.elementsAt(path)
+	if err != nil {
+		return Object{nil, err}
+	}
+	var best Object
+	var bestNumber number
+	for _, key := range keys {
+		if !key.IsExists() {
+			continue
+		}
+		n, ok := toNumber(*key.val)
+		if !ok {
+			continue
+		}
+		if !best.IsExists() || n.compare(bestNumber) == sign {
+			best, bestNumber = key, n
+		}
+	}
+	if !best.IsExists() {
+		return Object{nil, newError(ErrorElementNotFound)}
+	}
+	return best
+}
+
+// elementsAt - elements of the slice and their sub-objects at the path.
+func (o Object) elementsAt(path string) ([]interface{}, []Object, error) {
+	keys, err := ParsePath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	elements, err := o.elements()
+	if err != nil {
+		return nil, nil, err
+	}
+	values := make([]Object, len(elements))
+	for i, element := range elements {
+		values[i] = New(element).GetByPath(keys)
+	}
+	return elements, values, nil
+}
+
+// numbersAt - numbers at the path of the slice elements.
+func (o Object) numbersAt(path string) ([]number, error) {
+	_, keys, err := o.elementsAt(path)
+	if err != nil {
+		return nil, err
+	}
+	numbers := make([]number, 0, len(keys))
+	for _, key := range keys {
+		if !key.IsExists() {
+			continue
+		}
+		if n, ok := toNumber(*key.val); ok {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers, nil
+}
@@ -0,0 +1,125 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_Aggregates_Json(t *testing.T) {
+	object := NewFromJson([]byte(`[
+		{"id":1,"team":"a","score":10},
+		{"id":2,"team":"b","score":7.5},
+		{"id":3,"team":"a","score":"n/a"},
+		{"id":4,"team":"c"},
+		{"id":5,"team":"b","score":-2},
+		{"id":6,"score":null}
+	]`))
+
+	ids := func(obj Object) string {
+		data, _ := obj.Map(func(entry Entry) interface{} {
+			return entry.Value.Get("id")
+		}).ToJson()
+		return string(data)
+	}
+
+	t.Run("group by", func(t *testing.T) {
+		groups := object.GroupBy("team")
+		if groups.Len() != 3 || ids(groups.Get("a")) != `[1,3]` || ids(groups.Get("b")) != `[2,5]` {
+			t.Fatalf(`unexpected groups: %v`, groups.ToValue())
+		}
+	})
+
+	t.Run("group by numbers", func(t *testing.T) {
+		groups := NewFromJson([]byte(`[{"n":1},{"n":1.0},{"n":true}]`)).GroupBy("n")
+		if groups.Get("1").Len() != 2 || groups.Get("true").Len() != 1 {
+			t.Fatalf(`unexpected groups: %v`, groups.ToValue())
+		}
+	})
+
+	t.Run("group by mixed types", func(t *testing.T) {
+		for _, data := range []string{`[{"n":30},{"n":"30"}]`, `[{"n":"true"},{"n":true}]`} {
+			groups := NewFromJson([]byte(data)).GroupBy("n")
+			if groups.GetError() == nil || groups.GetError().Error() != ErrorKeyConflict {
+				t.Fatalf(`expect ErrorKeyConflict for %s, got: %v`, data, groups.ToValue())
+			}
+		}
+	})
+
+	t.Run("large ids", func(t *testing.T) {
+		big := New([]interface{}{
+			map[string]interface{}{"id": int64(9007199254740993)},
+			map[string]interface{}{"id": int64(9007199254740992)},
+			map[string]interface{}{"id": uint64(18446744073709551615)},
+			map[string]interface{}{"id": 9007199254740992.0},
+		})
+		if result := big.UniqBy("id").Len(); result != 3 {
+			t.Fatalf(`expect 3 unique ids, got: %d`, result)
+		}
+		groups := big.GroupBy("id")
+		if groups.Len() != 3 || groups.Get("9007199254740993").Len() != 1 ||
+			groups.Get("9007199254740992").Len() != 2 || groups.Get("18446744073709551615").Len() != 1 {
+			t.Fatalf(`unexpected groups: %v`, groups.ToValue())
+		}
+	})
+
+	t.Run("index by", func(t *testing.T) {
+		index := object.IndexBy("id")
+		if index.Len() != 6 || index.GetPath(`["4"].team`).ToValue() != "c" {
+			t.Fatalf(`unexpected index: %v`, index.ToValue())
+		}
+		if last := object.IndexBy("team").GetPath("a.id"); last.ToValue() != 3.0 {
+			t.Fatalf(`expect last element wins, got: %v`, last.ToValue())
+		}
+	})
+
+	t.Run("distinct", func(t *testing.T) {
+		data, _ := NewFromJson([]byte(`[1,"1",1.0,{"a":[1]},{"a":[1.0]},null,null]`)).Distinct().ToJson()
+		if string(data) != `[1,"1",{"a":[1]},null]` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	t.Run("uniq by", func(t *testing.T) {
+		if result := ids(object.UniqBy("team")); result != `[1,2,4,6]` {
+			t.Fatalf(`expect [1,2,4,6], got: %s`, result)
+		}
+	})
+
+	t.Run("count", func(t *testing.T) {
+		if count := object.Count("score"); count != 4 {
+			t.Fatalf(`expect 4, got: %d`, count)
+		}
+		if count := object.Count(""); count != 6 {
+			t.Fatalf(`expect 6, got: %d`, count)
+		}
+	})
+
+	t.Run("numeric aggregates", func(t *testing.T) {
+		if sum := object.Sum("score").ToValue(); sum != 15.5 {
+			t.Fatalf(`expect sum 15.5, got: %v`, sum)
+		}
+		if avg := object.Avg("score").ToValue(); avg != 15.5/3 {
+			t.Fatalf(`expect avg %v, got: %v`, 15.5/3, avg)
+		}
+		if min := object.Min("score").ToValue(); min != -2.0 {
+			t.Fatalf(`expect min -2, got: %v`, min)
+		}
+		if max := object.Max("score").ToValue(); max != 10.0 {
+			t.Fatalf(`expect max 10, got: %v`, max)
+		}
+	})
+
+	t.Run("no numbers", func(t *testing.T) {
+		if obj := object.Avg("team"); obj.GetError().Error() != ErrorElementNotFound {
+			t.Fatalf(`expect ErrorElementNotFound, got: %v`, obj.GetError())
+		}
+		if sum := object.Sum("team").ToValue(); sum != 0.0 {
+			t.Fatalf(`expect 0, got: %v`, sum)
+		}
+	})
+
+	t.Run("not slice", func(t *testing.T) {
+		if obj := object.GetIndex(0).GroupBy("id"); obj.GetError().Error() != ErrorTypeNotSupport {
+			t.Fatalf(`expect ErrorTypeNotSupport, got: %v`, obj.GetError())
+		}
+	})
+}
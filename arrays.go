@@ -0,0 +1,121 @@
+package object
+
+import (
+	"reflect"
+)
+
+// Len - count of elements of reflect.Slice, reflect.Array or reflect.Map
+// and count of bytes for reflect.String, like Go's len.
+// Returns 0 for other kinds and not exists objects.
+func (o Object) Len() int {
+	switch o.kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		return unwrap(*o.val).Len()
+	}
+	return 0
+}
+
+// At - get slice element by the index, negative index counts
+// from the end, so At(-1) is the last element.
+func (o Object) At(index int) Object {
+	if index < 0 {
+		switch o.kind() {
+		case reflect.Slice, reflect.Array:
+			index += o.Len()
+		}
+	}
+	return o.GetIndex(index)
+}
+
+// First - get the first slice element.
+func (o Object) First() Object {
+	return o.At(0)
+}
+
+// Last - get the last slice element.
+func (o Object) Last() Object {
+	return o.At(-1)
+}
+
+// Slice - make a new slice of elements from start to end (exclusive).
+// Negative indexes count from the end, out of range indexes are clamping,
+// like JavaScript's Array.prototype.slice does.
+func (o Object) Slice(start, end int) Object {
+	elements, err := o.elements()
+	if err != nil {
+		return Object{nil, err}
+	}
+	start, end = clampIndex(start, len(elements)), clampIndex(end, len(elements))
+	if start > end {
+		start = end
+	}
+	return New(append([]interface{}{}, elements[start:end]...))
+}
+
+// Concat - make a new slice of the elements followed by the values.
+// Slice values (Objects too) are spreading, other values are appending as is.
+func (o Object) Concat(values ...interface{}) Object {
+	elements, err := o.elements()
+	if err != nil {
+		return Object{nil, err}
+	}
+	result := append([]interface{}{}, elements...)
+	for _, value := range values {
+		other := New(valueOf(value))
+		if other.kind() == reflect.Slice || other.kind() == reflect.Array {
+			items, _ := other.elements()
+			result = append(result, items...)
+		} else {
+			result = append(result, other.ToValue())
+		}
+	}
+	return New(result)
+}
+
+// Reverse - make a new slice with the elements in reversed order.
+// Unlike JavaScript, the source slice isn't modifying.
+func (o Object) Reverse() Object {
+	elements, err := o.elements()
+	if err != nil {
+		return Object{nil, err}
+	}
+	result := make([]interface{}, len(elements))
+	for i, element := range elements {
+		result[len(elements)-1-i] = element
+	}
+	return New(result)
+}
+
+// elements - values of reflect.Slice or reflect.Array elements.
+func (o Object) elements() ([]interface{}, error) {
+	switch o.kind() {
+	case reflect.Invalid:
+		if !o.IsExists() {
+			return nil, newError(ErrorObjectNotExists)
+		}
+	case reflect.Slice, reflect.Array:
+		val := unwrap(*o.val)
+		elements := make([]interface{}, val.Len())
+		for i := range elements {
+			if v := elem(val.Index(i)); v.IsValid() && v.CanInterface() {
+				elements[i] = v.Interface()
+			}
+		}
+		return elements, nil
+	}
+	return nil, newError(ErrorTypeNotSupport)
+}
+
+// clampIndex - convert JavaScript-like index into the range [0, length].
+func clampIndex(index, length int) int {
+	if index < 0 {
+		index += length
+	}
+	if index < 0 {
+		return 0
+	}
+	if index > length {
+		return length
+	}
+	return index
+}
@@ -0,0 +1,94 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_Arrays_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{"a":{"b":"c"},"e":[3,2,1],"s":"value"}`))
+	e := object.Get("e")
+
+	toJson := func(obj Object) string {
+		data, err := obj.ToJson()
+		if err != nil {
+			return err.Error()
+		}
+		return string(data)
+	}
+
+	t.Run("len", func(t *testing.T) {
+		if e.Len() != 3 || object.Len() != 3 || object.Get("s").Len() != 5 || object.Get("x").Len() != 0 {
+			t.Fatalf(`unexpected lengths`)
+		}
+	})
+
+	t.Run("at", func(t *testing.T) {
+		if e.At(0).ToValue() != 3.0 || e.At(-1).ToValue() != 1.0 || e.At(-3).ToValue() != 3.0 {
+			t.Fatalf(`unexpected elements`)
+		}
+		if obj := e.At(-4); obj.GetError().Error() != ErrorIndexRange {
+			t.Fatalf(`expect ErrorIndexRange, got: %v`, obj.GetError())
+		}
+		if obj := object.Get("a").At(-1); obj.GetError().Error() != ErrorTypeNotSupport {
+			t.Fatalf(`expect ErrorTypeNotSupport, got: %v`, obj.GetError())
+		}
+	})
+
+	t.Run("first and last", func(t *testing.T) {
+		if e.First().ToValue() != 3.0 || e.Last().ToValue() != 1.0 {
+			t.Fatalf(`unexpected elements`)
+		}
+		if New([]interface{}{}).Last().IsExists() {
+			t.Fatalf(`expect not exists for empty slice`)
+		}
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		cases := []struct {
+			start, end int
+			json       string
+		}{
+			{0, 3, `[3,2,1]`},
+			{1, 2, `[2]`},
+			{-2, 10, `[2,1]`},
+			{2, 1, `[]`},
+			{-10, -1, `[3,2]`},
+		}
+		for _, c := range cases {
+			if result := toJson(e.Slice(c.start, c.end)); result != c.json {
+				t.Fatalf(`expect %s for (%d, %d), got: %s`, c.json, c.start, c.end, result)
+			}
+		}
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		result := e.Concat(object.Get("e"), []int{0}, "x", object.Get("a"))
+		if json := toJson(result); json != `[3,2,1,3,2,1,0,"x",{"b":"c"}]` {
+			t.Fatalf(`unexpected result: %s`, json)
+		}
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		if json := toJson(e.Reverse()); json != `[1,2,3]` {
+			t.Fatalf(`expect [1,2,3], got: %s`, json)
+		}
+		if json := toJson(e); json != `[3,2,1]` {
+			t.Fatalf(`expect untouched source, got: %s`, json)
+		}
+	})
+
+	t.Run("typed slice", func(t *testing.T) {
+		if obj := New([]string{"a", "b"}).At(-1); obj.ToValue() != "b" {
+			t.Fatalf(`expect "b", got: %v`, obj.ToValue())
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if obj := object.Get("s").Reverse(); obj.GetError().Error() != ErrorTypeNotSupport {
+			t.Fatalf(`expect ErrorTypeNotSupport, got: %v`, obj.GetError())
+		}
+		if obj := object.Get("x").Slice(0, 1); obj.GetError().Error() != ErrorObjectNotExists {
+			t.Fatalf(`expect ErrorObjectNotExists, got: %v`, obj.GetError())
+		}
+	})
+}
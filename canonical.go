@@ -0,0 +1,184 @@
+package object
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// ToCanonicalJson - serialize the object to the canonical json (RFC 8785, JCS).
+// Map keys are sorting by their UTF-16 code units, numbers are formatting
+// like in JavaScript (as float64), there are no extra whitespaces.
+// Integers which float64 can't represent exactly (like int64 ids above
+// 2^53) are failing with ErrorNumberPrecision instead of being rounded.
+// So same documents produce same bytes regardless of the source format.
+// Values which aren't plain data (like time.Time) are serializing with
+// encoding/json before the canonicalization.
+func (o Object) ToCanonicalJson() ([]byte, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	if !o.IsExists() {
+		return nil, newError(ErrorObjectNotExists)
+	}
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, plain(*o.val)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Hash - hex-encoded SHA-256 digest of the object's canonical json.
+// It's stable for same documents regardless of map iteration order
+// and the source format, so it fits well for deduplication and caching.
+// Like ToCanonicalJson it fails for integers float64 can't represent
+// exactly, so different 64-bit ids never have same hash.
+func (o Object) Hash() (string, error) {
+	data, err := o.ToCanonicalJson()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// writeCanonical - write the plain value as the canonical json.
+func writeCanonical(buf *bytes.Buffer, v interface{}) error {
+	if n, ok := toNumber(reflect.ValueOf(v)); ok {
+		if !n.isFloat() {
+			return newError(ErrorNumberPrecision)
+		}
+		s, err := formatCanonicalNumber(n.float)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+		return nil
+	}
+
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case string:
+		writeCanonicalString(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUtf16(keys[i], keys[j])
+		})
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, key)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return newError(ErrorTypeNotSupport)
+		}
+		var document interface{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&document); err != nil {
+			return newError(ErrorTypeNotSupport)
+		}
+		return writeCanonical(buf, document)
+	}
+	return nil
+}
+
+// formatCanonicalNumber - format the number like JavaScript's
+// Number.prototype.toString does.
+func formatCanonicalNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", newError(ErrorTypeNotSupport)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+	abs := math.Abs(f)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exponent := s[:strings.IndexByte(s, 'e')+2], s[strings.IndexByte(s, 'e')+2:]
+	return mantissa + strings.TrimLeft(exponent, "0"), nil
+}
+
+// writeCanonicalString - write the string as the canonical json string.
+// Only quotes, backslashes and control characters are escaping.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	const hexDigits = "0123456789abcdef"
+	buf.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[r>>4])
+				buf.WriteByte(hexDigits[r&0xf])
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// lessUtf16 - compare strings by their UTF-16 code units.
+func lessUtf16(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+}
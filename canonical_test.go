@@ -0,0 +1,79 @@
+package object
+
+import (
+	"testing"
+	"time"
+)
+
+func TestObject_ToCanonicalJson(t *testing.T) {
+	cases := []struct {
+		name   string
+		source Object
+		json   string
+	}{
+		{"sorted keys", NewFromJson([]byte(`{"b": 1, "a": {"d": [1, 2], "c": null}}`)), `{"a":{"c":null,"d":[1,2]},"b":1}`},
+		{"utf-16 key order", NewFromJson([]byte(`{"\u20ac":1,"\ud83d\ude00":2,"\r":3,"1":4,"\ufb33":5}`)), "{\"\\r\":3,\"1\":4,\"€\":1,\"😀\":2,\"דּ\":5}"},
+		{"numbers", New([]interface{}{0.0, -0.0, 1e21, 1e-7, 123.456, 1e20, int64(-5), uint8(3)}), `[0,0,1e+21,1e-7,123.456,100000000000000000000,-5,3]`},
+		{"string escapes", New("\"\\\b\f\n\r\t\x01</€"), `"\"\\\b\f\n\r\t\u0001</€"`},
+		{"yaml source", NewFromYaml([]byte("b: 1\na:\n  d: [1, 2]\n  c: null\n")), `{"a":{"c":null,"d":[1,2]},"b":1}`},
+		{"non plain value", New(map[string]interface{}{"t": time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)}), `{"t":"2021-01-02T03:04:05Z"}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := c.source.ToCanonicalJson()
+			if err != nil {
+				t.Fatalf(`unexpected error: %v`, err)
+			}
+			if string(data) != c.json {
+				t.Fatalf(`expect %s, got: %s`, c.json, data)
+			}
+		})
+	}
+
+	t.Run("inexact integers", func(t *testing.T) {
+		for _, v := range []interface{}{int64(9007199254740993), uint64(1<<64 - 1), int64(1<<63 - 1)} {
+			if _, err := New([]interface{}{v}).ToCanonicalJson(); err == nil || err.Error() != ErrorNumberPrecision {
+				t.Fatalf(`expect ErrorNumberPrecision for %d, got: %v`, v, err)
+			}
+		}
+		if data, _ := New([]interface{}{int64(-1 << 63), uint64(1 << 63), int64(9007199254740992)}).ToCanonicalJson(); string(data) != `[-9223372036854776000,9223372036854776000,9007199254740992]` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	t.Run("not exists", func(t *testing.T) {
+		if _, err := New(nil).Get("a").ToCanonicalJson(); err == nil {
+			t.Fatalf(`expect error`)
+		}
+	})
+}
+
+func TestObject_Hash(t *testing.T) {
+	a, _ := NewFromJson([]byte(`{"a":[1,2,{"b":true}],"c":"d"}`)).Hash()
+	b, _ := NewFromYaml([]byte("c: d\na:\n  - 1\n  - 2\n  - b: true\n")).Hash()
+	c, _ := NewFromJson([]byte(`{"a":[2,1,{"b":true}],"c":"d"}`)).Hash()
+
+	t.Run("same content", func(t *testing.T) {
+		if a != b {
+			t.Fatalf(`expect equal hashes, got: %s and %s`, a, b)
+		}
+	})
+
+	t.Run("different content", func(t *testing.T) {
+		if a == c {
+			t.Fatalf(`expect different hashes`)
+		}
+	})
+
+	t.Run("large ids", func(t *testing.T) {
+		if _, err := New(int64(9007199254740993)).Hash(); err == nil || err.Error() != ErrorNumberPrecision {
+			t.Fatalf(`expect ErrorNumberPrecision, got: %v`, err)
+		}
+	})
+
+	t.Run("sha-256 length", func(t *testing.T) {
+		if len(a) != 64 {
+			t.Fatalf(`expect 64 hex digits, got: %s`, a)
+		}
+	})
+}
@@ -120,55 +120,33 @@ func (o Object) IsFloat() bool {
 }
 
 // IsStringStrict - check that the object is string.
+// Numbers in strings like json.Number aren't strings.
 func (o Object) IsStringStrict() bool {
-	return o.IsExists() && o.val.Kind() == reflect.Map
+	_, err := o.StringWith(CoercionStrict)
+	return err == nil
 }
 
 // IsString - check that the object is string or can be cast.
-// Simple types like numbers always can be cast. If you would like
-// to get something more complicated - use ToJson or similar serialization.
-// If the required type serialization isn't presented - use ToValue and
-// pass it to your favourite serializer manually.
+// Simple types like numbers and booleans always can be cast, also byte
+// slices and times. If you would like to get something more complicated - use
+// ToJson or similar serialization. If the required type serialization
+// isn't presented - use ToValue and pass it to your favourite serializer manually.
 func (o Object) IsString() bool {
-	if !o.IsExists() {
-		return false
-	}
-	if o.IsStringStrict() || o.IsFloatStrict() {
-		return true
-	}
-	return false
+	_, err := o.StringWith(CoercionLenient)
+	return err == nil
 }
 
 // IsBoolStrict - check that the object is boolean.
 func (o Object) IsBoolStrict() bool {
-	return o.IsExists() && o.val.Kind() == reflect.Bool
+	_, err := o.BoolWith(CoercionStrict)
+	return err == nil
 }
 
 // IsBool - check that the object is boolean or can be cast.
-// For string truthy values are: "true", "yes", "on"
-// and if it can be cast to number - any except zero.
+// For string truthy values are: "true", "yes", "on", falsy values are:
+// "false", "no", "off" and if it can be cast to number - any except zero.
 // For numbers truthy values any except zero.
-//TODO: Make the realisation and tests
 func (o Object) IsBool() bool {
-	if !o.IsExists() {
-		return false
-	}
-	if o.IsBoolStrict() {
-		return true
-	}
-	switch o.val.Kind() {
-	case reflect.Float32, reflect.Float64:
-		if float64(int64(o.val.Float())) == o.val.Float() {
-			return true
-		}
-	case reflect.String:
-		num, err := strconv.ParseFloat(o.val.String(), 64)
-		if err != nil {
-			return false
-		}
-		if float64(int64(num)) == num {
-			return true
-		}
-	}
-	return false
+	_, err := o.BoolWith(CoercionLenient)
+	return err == nil
 }
@@ -1,6 +1,7 @@
 package object
 
 import (
+	"encoding/json"
 	"testing"
 )
 
@@ -294,3 +295,123 @@ func TestObject_IsFloat(t *testing.T) {
 		}
 	})
 }
+
+func TestObject_IsStringStrict(t *testing.T) {
+	document := map[string]interface{}{}
+	document["string"] = "value"
+	document["empty"] = ""
+	document["non_string1"] = 5
+	document["non_string2"] = map[string]interface{}{}
+	document["non_string3"] = json.Number("5")
+	object := New(document)
+
+	t.Run("string", func(t *testing.T) {
+		if !object.Get("string").IsStringStrict() || !object.Get("empty").IsStringStrict() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("number", func(t *testing.T) {
+		if object.Get("non_string1").IsStringStrict() || object.Get("non_string3").IsStringStrict() {
+			t.Fatalf(`expect false`)
+		}
+	})
+
+	t.Run("map", func(t *testing.T) {
+		if object.Get("non_string2").IsStringStrict() || object.IsStringStrict() {
+			t.Fatalf(`expect false`)
+		}
+	})
+}
+
+func TestObject_IsString(t *testing.T) {
+	document := map[string]interface{}{}
+	document["string1"] = "value"
+	document["string2"] = 5
+	document["string3"] = 5.5
+	document["string4"] = true
+	document["non_string1"] = nil
+	document["non_string2"] = []interface{}{"value"}
+	object := New(document)
+
+	t.Run("string", func(t *testing.T) {
+		if !object.Get("string1").IsString() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("numbers and booleans can be cast", func(t *testing.T) {
+		if !object.Get("string2").IsString() || !object.Get("string3").IsString() || !object.Get("string4").IsString() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("null, slices and maps can't be cast", func(t *testing.T) {
+		if object.Get("non_string1").IsString() || object.Get("non_string2").IsString() || object.IsString() {
+			t.Fatalf(`expect false`)
+		}
+	})
+
+	t.Run("not exists", func(t *testing.T) {
+		if object.Get("not exists").IsString() {
+			t.Fatalf(`expect false`)
+		}
+	})
+}
+
+func TestObject_IsBoolStrict(t *testing.T) {
+	document := map[string]interface{}{}
+	document["bool"] = false
+	document["non_bool1"] = "true"
+	document["non_bool2"] = 1
+	object := New(document)
+
+	t.Run("bool", func(t *testing.T) {
+		if !object.Get("bool").IsBoolStrict() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("string and number", func(t *testing.T) {
+		if object.Get("non_bool1").IsBoolStrict() || object.Get("non_bool2").IsBoolStrict() {
+			t.Fatalf(`expect false`)
+		}
+	})
+}
+
+func TestObject_IsBool(t *testing.T) {
+	document := map[string]interface{}{}
+	document["bool1"] = true
+	document["bool2"] = "Yes"
+	document["bool3"] = "off"
+	document["bool4"] = 0.5
+	document["bool5"] = "0"
+	document["non_bool1"] = "value"
+	document["non_bool2"] = ""
+	document["non_bool3"] = []interface{}{}
+	object := New(document)
+
+	t.Run("bool", func(t *testing.T) {
+		if !object.Get("bool1").IsBool() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("truthy and falsy strings", func(t *testing.T) {
+		if !object.Get("bool2").IsBool() || !object.Get("bool3").IsBool() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("numbers and strings with numbers", func(t *testing.T) {
+		if !object.Get("bool4").IsBool() || !object.Get("bool5").IsBool() {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("other strings and slices", func(t *testing.T) {
+		if object.Get("non_bool1").IsBool() || object.Get("non_bool2").IsBool() || object.Get("non_bool3").IsBool() {
+			t.Fatalf(`expect false`)
+		}
+	})
+}
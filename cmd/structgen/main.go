@@ -0,0 +1,85 @@
+// Command structgen prints Go struct definitions for sample documents
+// of any format supported by object.NewFromData (JSON, YAML, TOML, BSON).
+//
+//	structgen -name Config -package config a.yaml b.yaml
+//	structgen -ndjson -name Event < events.ndjson
+//
+// Every file is a sample, with -ndjson every line of files is a sample.
+// Without files the samples are reading from stdin.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/the-go-tool/object"
+	"github.com/the-go-tool/object/structgen"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	name := flag.String("name", "Root", "name of the root type")
+	pkg := flag.String("package", "", "package clause of the output")
+	tags := flag.String("tags", "json,yaml,toml", "comma-separated struct tags")
+	ndjson := flag.Bool("ndjson", false, "treat every line as a sample")
+	flag.Parse()
+
+	samples, err := readSamples(flag.Args(), *ndjson)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "structgen:", err)
+		os.Exit(1)
+	}
+	options := structgen.Options{Package: *pkg, Name: *name, Tags: []string{}}
+	for _, tag := range strings.Split(*tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			options.Tags = append(options.Tags, tag)
+		}
+	}
+	source, err := structgen.GenerateWith(options, samples...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "structgen:", err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(source)
+}
+
+func readSamples(files []string, ndjson bool) ([]object.Object, error) {
+	documents := make([][]byte, 0)
+	if len(files) == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, data)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, data)
+	}
+	if ndjson {
+		lines := make([][]byte, 0)
+		for _, document := range documents {
+			for _, line := range bytes.Split(document, []byte("\n")) {
+				if len(bytes.TrimSpace(line)) > 0 {
+					lines = append(lines, line)
+				}
+			}
+		}
+		documents = lines
+	}
+
+	samples := make([]object.Object, 0, len(documents))
+	for i, document := range documents {
+		sample := object.NewFromData(document)
+		if err := sample.GetError(); err != nil {
+			return nil, fmt.Errorf("sample %d: %w", i+1, err)
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
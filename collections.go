@@ -0,0 +1,213 @@
+package object
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// entries - key-values of reflect.Map (in ascending keys order)
+// or reflect.Slice. Returns false for other kinds and byte slices.
+func (o Object) entries() ([]Entry, bool) {
+	if !o.IsExists() {
+		return nil, false
+	}
+
+	val := unwrap(*o.val)
+	switch val.Kind() {
+	case reflect.Map:
+		entries := make([]Entry, 0, val.Len())
+		iter := val.MapRange()
+		for iter.Next() {
+			v := elem(iter.Value())
+			entries = append(entries, Entry{keyString(iter.Key()), Object{&v, nil}})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Key < entries[j].Key
+		})
+		return entries, true
+	case reflect.Slice, reflect.Array:
+		if isBytes(val) {
+			return nil, false
+		}
+		entries := make([]Entry, 0, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			v := elem(val.Index(i))
+			entries = append(entries, Entry{strconv.Itoa(i), Object{&v, nil}})
+		}
+		return entries, true
+	}
+	return nil, false
+}
+
+// kind - kind of the object's value with pointers and interfaces unpacked.
+func (o Object) kind() reflect.Kind {
+	if !o.IsExists() {
+		return reflect.Invalid
+	}
+	return unwrap(*o.val).Kind()
+}
+
+// collectionError - error for collection methods applied to wrong object.
+func (o Object) collectionError() Object {
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	return Object{nil, newError(ErrorTypeNotSupport)}
+}
+
+// valueOf - value of the callback result, Object is unpacking.
+func valueOf(v interface{}) interface{} {
+	if obj, ok := v.(Object); ok {
+		return obj.ToValue()
+	}
+	return v
+}
+
+// ForEach - call fn for every entry of reflect.Map or reflect.Slice.
+// Map entries are visiting in ascending keys order.
+// Returns the object itself to continue the chain.
+func (o Object) ForEach(fn func(entry Entry)) Object {
+	entries, ok := o.entries()
+	if !ok {
+		return o.collectionError()
+	}
+	for _, entry := range entries {
+		fn(entry)
+	}
+	return o
+}
+
+// Map - make a new collection of the same kind with values returned by fn.
+// Maps keep their keys, slices keep their order.
+// The fn can return Object as well as any other value.
+func (o Object) Map(fn func(entry Entry) interface{}) Object {
+	entries, ok := o.entries()
+	if !ok {
+		return o.collectionError()
+	}
+	if o.kind() == reflect.Map {
+		result := make(map[string]interface{}, len(entries))
+		for _, entry := range entries {
+			result[entry.Key] = valueOf(fn(entry))
+		}
+		return New(result)
+	}
+	result := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, valueOf(fn(entry)))
+	}
+	return New(result)
+}
+
+// Filter - make a new collection of the same kind only with entries
+// for which fn returns true. Slices are re-indexing.
+func (o Object) Filter(fn func(entry Entry) bool) Object {
+	entries, ok := o.entries()
+	if !ok {
+		return o.collectionError()
+	}
+	if o.kind() == reflect.Map {
+		result := make(map[string]interface{}, len(entries))
+		for _, entry := range entries {
+			if fn(entry) {
+				result[entry.Key] = entry.Value.ToValue()
+			}
+		}
+		return New(result)
+	}
+	result := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		if fn(entry) {
+			result = append(result, entry.Value.ToValue())
+		}
+	}
+	return New(result)
+}
+
+// Reduce - accumulate entries into a single value starting from initial.
+// Map entries are visiting in ascending keys order.
+func (o Object) Reduce(fn func(acc Object, entry Entry) interface{}, initial interface{}) Object {
+	entries, ok := o.entries()
+	if !ok {
+		return o.collectionError()
+	}
+	acc := New(valueOf(initial))
+	for _, entry := range entries {
+		acc = New(valueOf(fn(acc, entry)))
+	}
+	return acc
+}
+
+// Find - get the first value for which fn returns true.
+// Map entries are visiting in ascending keys order.
+func (o Object) Find(fn func(entry Entry) bool) Object {
+	entries, ok := o.entries()
+	if !ok {
+		return o.collectionError()
+	}
+	for _, entry := range entries {
+		if fn(entry) {
+			return entry.Value
+		}
+	}
+	return Object{nil, newError(ErrorElementNotFound)}
+}
+
+// FindIndex - get the index of the first slice element for which fn
+// returns true. Returns -1 if nothing found or the object isn't a slice.
+func (o Object) FindIndex(fn func(entry Entry) bool) int {
+	if kind := o.kind(); kind != reflect.Slice && kind != reflect.Array {
+		return -1
+	}
+	entries, _ := o.entries()
+	for i, entry := range entries {
+		if fn(entry) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Some - check that fn returns true for at least one entry.
+func (o Object) Some(fn func(entry Entry) bool) bool {
+	entries, _ := o.entries()
+	for _, entry := range entries {
+		if fn(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// Every - check that fn returns true for all entries.
+// Returns true for empty collections and false for non-collections.
+func (o Object) Every(fn func(entry Entry) bool) bool {
+	entries, ok := o.entries()
+	if !ok {
+		return false
+	}
+	for _, entry := range entries {
+		if !fn(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+// Includes - check that any value of the collection is equal to the value.
+// Values are comparing like in Equal.
+func (o Object) Includes(value interface{}) bool {
+	return o.Some(func(entry Entry) bool {
+		return Equal(entry.Value, New(valueOf(value)))
+	})
+}
+
+// IndexOf - get the index of the first slice element equal to the value.
+// Values are comparing like in Equal. Returns -1 if nothing found
+// or the object isn't a slice.
+func (o Object) IndexOf(value interface{}) int {
+	return o.FindIndex(func(entry Entry) bool {
+		return Equal(entry.Value, New(valueOf(value)))
+	})
+}
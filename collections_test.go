@@ -0,0 +1,131 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObject_Collections_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{"a":{"x":1,"y":2,"z":3},"e":[3,2,1],"s":"value"}`))
+
+	isFloat := func(f float64) func(entry Entry) bool {
+		return func(entry Entry) bool {
+			return entry.Value.ToValue() == f
+		}
+	}
+
+	t.Run("for each", func(t *testing.T) {
+		keys := make([]string, 0, 3)
+		object.Get("a").ForEach(func(entry Entry) {
+			keys = append(keys, entry.Key)
+		})
+		if !reflect.DeepEqual(keys, []string{"x", "y", "z"}) {
+			t.Fatalf(`expect [x y z], got: %v`, keys)
+		}
+	})
+
+	t.Run("map slice", func(t *testing.T) {
+		result := object.Get("e").Map(func(entry Entry) interface{} {
+			return entry.Value.ToValue().(float64) * 10
+		})
+		if data, _ := result.ToJson(); string(data) != `[30,20,10]` {
+			t.Fatalf(`expect [30,20,10], got: %s`, data)
+		}
+	})
+
+	t.Run("map map with objects", func(t *testing.T) {
+		result := object.Get("a").Map(func(entry Entry) interface{} {
+			return object.Get("e").GetIndex(int(entry.Value.ToValue().(float64)) - 1)
+		})
+		if data, _ := result.ToJson(); string(data) != `{"x":3,"y":2,"z":1}` {
+			t.Fatalf(`expect {"x":3,"y":2,"z":1}, got: %s`, data)
+		}
+	})
+
+	t.Run("filter chain", func(t *testing.T) {
+		result := object.Get("e").Filter(func(entry Entry) bool {
+			return entry.Value.ToValue().(float64) > 1
+		}).Map(func(entry Entry) interface{} {
+			return entry.Key
+		})
+		if data, _ := result.ToJson(); string(data) != `["0","1"]` {
+			t.Fatalf(`expect ["0","1"], got: %s`, data)
+		}
+	})
+
+	t.Run("filter map", func(t *testing.T) {
+		result := object.Get("a").Filter(func(entry Entry) bool {
+			return entry.Key != "y"
+		})
+		if data, _ := result.ToJson(); string(data) != `{"x":1,"z":3}` {
+			t.Fatalf(`expect {"x":1,"z":3}, got: %s`, data)
+		}
+	})
+
+	t.Run("reduce", func(t *testing.T) {
+		result := object.Get("a").Reduce(func(acc Object, entry Entry) interface{} {
+			return acc.ToValue().(string) + entry.Key
+		}, "keys:")
+		if result.ToValue() != "keys:xyz" {
+			t.Fatalf(`expect "keys:xyz", got: %v`, result.ToValue())
+		}
+	})
+
+	t.Run("find", func(t *testing.T) {
+		if key := object.Get("a").Find(isFloat(2)); key.ToValue() != 2.0 {
+			t.Fatalf(`expect 2, got: %v`, key.ToValue())
+		}
+		if obj := object.Get("a").Find(isFloat(5)); obj.GetError().Error() != ErrorElementNotFound {
+			t.Fatalf(`expect ErrorElementNotFound, got: %v`, obj.GetError())
+		}
+	})
+
+	t.Run("find index", func(t *testing.T) {
+		if index := object.Get("e").FindIndex(isFloat(1)); index != 2 {
+			t.Fatalf(`expect 2, got: %v`, index)
+		}
+		if index := object.Get("a").FindIndex(isFloat(1)); index != -1 {
+			t.Fatalf(`expect -1 for map, got: %v`, index)
+		}
+	})
+
+	t.Run("some and every", func(t *testing.T) {
+		if !object.Get("e").Some(isFloat(3)) || object.Get("e").Some(isFloat(4)) {
+			t.Fatalf(`unexpected Some result`)
+		}
+		positive := func(entry Entry) bool { return entry.Value.ToValue().(float64) > 0 }
+		if !object.Get("e").Every(positive) || object.Get("e").Every(isFloat(3)) {
+			t.Fatalf(`unexpected Every result`)
+		}
+		if !New([]interface{}{}).Every(positive) {
+			t.Fatalf(`expect true for empty slice`)
+		}
+	})
+
+	t.Run("includes and index of", func(t *testing.T) {
+		if !object.Get("e").Includes(2) || object.Get("e").Includes("2") {
+			t.Fatalf(`unexpected Includes result`)
+		}
+		if index := object.Get("e").IndexOf(uint8(1)); index != 2 {
+			t.Fatalf(`expect 2, got: %v`, index)
+		}
+	})
+
+	t.Run("typed slice", func(t *testing.T) {
+		result := New([]int{1, 2, 3}).Filter(func(entry Entry) bool {
+			return entry.Value.ToValue().(int) != 2
+		})
+		if data, _ := result.ToJson(); string(data) != `[1,3]` {
+			t.Fatalf(`expect [1,3], got: %s`, data)
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		if obj := object.Get("s").Map(func(Entry) interface{} { return nil }); obj.GetError().Error() != ErrorTypeNotSupport {
+			t.Fatalf(`expect ErrorTypeNotSupport, got: %v`, obj.GetError())
+		}
+		if obj := object.Get("x").ForEach(func(Entry) {}); obj.GetError().Error() != ErrorObjectNotExists {
+			t.Fatalf(`expect ErrorObjectNotExists, got: %v`, obj.GetError())
+		}
+	})
+}
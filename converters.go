@@ -0,0 +1,153 @@
+package object
+
+import (
+	"encoding/json"
+	"gopkg.in/mgo.v2/bson"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Coercion - policy of values conversion.
+type Coercion int
+
+const (
+	// CoercionLenient - values are casting if it's possible: numbers, booleans,
+	// byte slices and times are strings, numbers and strings like
+	// "yes" or "off" are booleans.
+	CoercionLenient Coercion = iota
+	// CoercionStrict - values aren't casting, only strings are strings
+	// and only booleans are booleans.
+	CoercionStrict
+)
+
+// ToValue - get the underlying value of the object.
+// Returns nil if the object isn't exists.
+func (o Object) ToValue() interface{} {
+	if !o.IsExists() || !o.val.IsValid() || !o.val.CanInterface() {
+		return nil
+	}
+	return o.val.Interface()
+}
+
+// ToJson - serialize the object to json.
+// Maps with non-string keys (like some yaml documents) are
+// serializing with their keys formatted as strings.
+func (o Object) ToJson() ([]byte, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	if !o.IsExists() {
+		return nil, newError(ErrorObjectNotExists)
+	}
+	return json.Marshal(plain(*o.val))
+}
+
+// String - get the string value of the object or cast it (like IsString).
+// Returns empty string if it's impossible, use StringWith to get the error.
+func (o Object) String() string {
+	s, _ := o.StringWith(CoercionLenient)
+	return s
+}
+
+// StringWith - get the string value of the object with the coercion policy.
+// Numbers are formatted like in JavaScript (5, 0.5, 1e+21), times
+// are formatted as RFC 3339, bson.ObjectId is formatted as hex.
+// Returns ErrorTypeNotSupport for other values, like null, maps and slices,
+// use ToJson for them.
+func (o Object) StringWith(coercion Coercion) (string, error) {
+	t := o.Type()
+	if t == TypeMissing {
+		return "", newError(ErrorObjectNotExists)
+	}
+	if t != TypeString && (coercion == CoercionStrict || t == TypeNull || t == TypeArray || t == TypeMap || t == TypeOther) {
+		return "", newError(ErrorTypeNotSupport)
+	}
+
+	v := unwrap(*o.val)
+	var value interface{}
+	if v.CanInterface() {
+		value = v.Interface()
+	}
+	switch value := value.(type) {
+	case bson.ObjectId:
+		return value.Hex(), nil
+	case json.Number:
+		return value.String(), nil
+	case bson.Decimal128:
+		return value.String(), nil
+	case bson.Binary:
+		return string(value.Data), nil
+	case time.Time:
+		return value.Format(time.RFC3339Nano), nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+	case reflect.Float64:
+		if s, err := formatCanonicalNumber(v.Float()); err == nil {
+			return s, nil
+		}
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case reflect.Slice:
+		return string(v.Bytes()), nil
+	case reflect.Array:
+		bytes := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(bytes), v)
+		return string(bytes), nil
+	}
+	return "", newError(ErrorTypeNotSupport)
+}
+
+// Bool - get the boolean value of the object or cast it (like IsBool).
+// Returns false if it's impossible, use BoolWith to get the error.
+func (o Object) Bool() bool {
+	b, _ := o.BoolWith(CoercionLenient)
+	return b
+}
+
+// BoolWith - get the boolean value of the object with the coercion policy.
+// For strings truthy values are "true", "yes", "on" and falsy values
+// are "false", "no", "off" (in any case), also strings with numbers are
+// casting like numbers. Numbers are truthy except zero (and NaN).
+// Returns ErrorTypeNotSupport for other values.
+func (o Object) BoolWith(coercion Coercion) (bool, error) {
+	t := o.Type()
+	if t == TypeMissing {
+		return false, newError(ErrorObjectNotExists)
+	}
+	v := unwrap(*o.val)
+	if t == TypeBool {
+		return v.Bool(), nil
+	}
+	if coercion == CoercionStrict {
+		return false, newError(ErrorTypeNotSupport)
+	}
+
+	if n, ok := toNumber(v); ok {
+		return n.float != 0 && !math.IsNaN(n.float), nil
+	}
+	if t == TypeString {
+		s, _ := o.StringWith(coercion)
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "true", "yes", "on":
+			return true, nil
+		case "false", "no", "off":
+			return false, nil
+		}
+		if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+			return f != 0 && !math.IsNaN(f), nil
+		}
+	}
+	return false, newError(ErrorTypeNotSupport)
+}
@@ -0,0 +1,117 @@
+package object
+
+import (
+	"encoding/json"
+	"gopkg.in/mgo.v2/bson"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestObject_StringWith(t *testing.T) {
+	id := bson.ObjectIdHex("5f1b2c3d4e5f60718293a4b5")
+	moment := time.Date(2021, 5, 6, 7, 8, 9, 500, time.UTC)
+	text := "pointer"
+
+	// expected lenient and strict results, "-" means the error
+	cases := []struct {
+		name    string
+		object  Object
+		lenient string
+		strict  string
+	}{
+		{"string", New("value"), "value", "value"},
+		{"empty string", New(""), "", ""},
+		{"pointer to string", New(&text), "pointer", "pointer"},
+		{"object id", New(id), "5f1b2c3d4e5f60718293a4b5", "5f1b2c3d4e5f60718293a4b5"},
+		{"int", New(-5), "-5", "-"},
+		{"uint64", New(uint64(math.MaxUint64)), "18446744073709551615", "-"},
+		{"json integer", NewFromJson([]byte(`{"a":5}`)).Get("a"), "5", "-"},
+		{"json float", NewFromJson([]byte(`{"a":0.1}`)).Get("a"), "0.1", "-"},
+		{"float32", New(float32(0.1)), "0.1", "-"},
+		{"big float", New(1e21), "1e+21", "-"},
+		{"infinity", New(math.Inf(-1)), "-Inf", "-"},
+		{"json.Number", New(json.Number("1.50")), "1.50", "-"},
+		{"true", New(true), "true", "-"},
+		{"bytes", New([]byte("raw")), "raw", "-"},
+		{"bson binary", New(bson.Binary{Data: []byte("raw")}), "raw", "-"},
+		{"time", New(moment), "2021-05-06T07:08:09.0000005Z", "-"},
+		{"null", NewFromJson([]byte(`{"a":null}`)).Get("a"), "-", "-"},
+		{"slice", New([]int{1}), "-", "-"},
+		{"map", New(map[string]int{}), "-", "-"},
+		{"missing", New(1).Get("a"), "-", "-"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, policy := range []struct {
+				coercion Coercion
+				expected string
+			}{{CoercionLenient, c.lenient}, {CoercionStrict, c.strict}} {
+				result, err := c.object.StringWith(policy.coercion)
+				if err != nil {
+					result = "-"
+				}
+				if result != policy.expected {
+					t.Fatalf(`expect %q with coercion %d, got: %q (%v)`, policy.expected, policy.coercion, result, err)
+				}
+			}
+			if lenient := c.object.String(); c.lenient != "-" && lenient != c.lenient || c.lenient == "-" && lenient != "" {
+				t.Fatalf(`unexpected String result: %q`, lenient)
+			}
+		})
+	}
+}
+
+func TestObject_BoolWith(t *testing.T) {
+	// expected lenient and strict results, "-" means the error
+	cases := []struct {
+		name    string
+		object  Object
+		lenient string
+		strict  string
+	}{
+		{"true", New(true), "true", "true"},
+		{"false", New(false), "false", "false"},
+		{"yaml bool", NewFromYaml([]byte(`a: true`)).Get("a"), "true", "true"},
+		{"yes", New("yes"), "true", "-"},
+		{"on", New("ON"), "true", "-"},
+		{"true string", New(" True "), "true", "-"},
+		{"no", New("no"), "false", "-"},
+		{"off", New("Off"), "false", "-"},
+		{"false string", New("false"), "false", "-"},
+		{"string number", New("2.5"), "true", "-"},
+		{"string zero", New("0"), "false", "-"},
+		{"other string", New("maybe"), "-", "-"},
+		{"empty string", New(""), "-", "-"},
+		{"int", New(-1), "true", "-"},
+		{"zero", New(0), "false", "-"},
+		{"float", New(0.1), "true", "-"},
+		{"nan", New(math.NaN()), "false", "-"},
+		{"json.Number", New(json.Number("0")), "false", "-"},
+		{"null", New(nil), "-", "-"},
+		{"slice", New([]bool{true}), "-", "-"},
+		{"missing", New(1).Get("a"), "-", "-"},
+	}
+	format := func(b bool, err error) string {
+		if err != nil {
+			return "-"
+		}
+		if b {
+			return "true"
+		}
+		return "false"
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if result := format(c.object.BoolWith(CoercionLenient)); result != c.lenient {
+				t.Fatalf(`expect lenient %s, got: %s`, c.lenient, result)
+			}
+			if result := format(c.object.BoolWith(CoercionStrict)); result != c.strict {
+				t.Fatalf(`expect strict %s, got: %s`, c.strict, result)
+			}
+			if c.object.Bool() != (c.lenient == "true") {
+				t.Fatalf(`unexpected Bool result: %v`, c.object.Bool())
+			}
+		})
+	}
+}
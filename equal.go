@@ -0,0 +1,126 @@
+package object
+
+import "strconv"
+
+// EqualOptions - options of the objects comparison.
+type EqualOptions struct {
+	// IgnorePaths - paths (ParsePath syntax) which aren't comparing.
+	// Key "*" matches any key, like `items[*].id`.
+	IgnorePaths []string
+	// NilEqualsEmpty - consider null, empty slice and empty map equal.
+	NilEqualsEmpty bool
+}
+
+// Equal - deep structural equality of the objects.
+// Numbers are comparing by their values regardless of their types
+// (int64, uint64, float64, json.Number), ordered bson.D documents are
+// comparing as maps. So same document decoded from different formats
+// is equal. Not existing objects are equal only to not existing ones.
+func Equal(a, b Object) bool {
+	return EqualWith(a, b, EqualOptions{})
+}
+
+// EqualWith - acts like Equal but with comparison options.
+// Invalid ignore paths are skipping.
+func EqualWith(a, b Object, options EqualOptions) bool {
+	if !a.IsExists() || !b.IsExists() {
+		return a.IsExists() == b.IsExists()
+	}
+	ignores := make([]Path, 0, len(options.IgnorePaths))
+	for _, p := range options.IgnorePaths {
+		if path, err := ParsePath(p); err == nil {
+			ignores = append(ignores, path)
+		}
+	}
+	eq := equality{ignores, options.NilEqualsEmpty}
+	return eq.equal(Path{}, plain(*a.val), plain(*b.val))
+}
+
+// equality - plain values comparator.
+type equality struct {
+	ignores        []Path
+	nilEqualsEmpty bool
+}
+
+func (eq equality) equal(path Path, a, b interface{}) bool {
+	if eq.ignored(path) {
+		return true
+	}
+	if eq.nilEqualsEmpty && isNilOrEmpty(a) && isNilOrEmpty(b) {
+		return true
+	}
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok {
+				if eq.ignored(path.Append(key)) {
+					continue
+				}
+				return false
+			}
+			if !eq.equal(path.Append(key), value, other) {
+				return false
+			}
+		}
+		for key := range bv {
+			if _, ok := av[key]; !ok && !eq.ignored(path.Append(key)) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !eq.equal(path.Append(strconv.Itoa(i)), av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return equalPlain(a, b)
+}
+
+// ignored - check that the path matches any of ignoring paths.
+func (eq equality) ignored(path Path) bool {
+	for _, ignore := range eq.ignores {
+		if matchPath(ignore, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath - check that the path matches the pattern
+// where "*" key matches any key.
+func matchPath(pattern, path Path) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isNilOrEmpty - check that the plain value is null, empty slice or map.
+func isNilOrEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package object
+
+import (
+	"encoding/json"
+	"gopkg.in/mgo.v2/bson"
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	jsonObject := NewFromJson([]byte(`{"a":{"b":"c","d":-500.5},"e":[3,2,1],"f":null}`))
+
+	t.Run("json and yaml", func(t *testing.T) {
+		yamlObject := NewFromYaml([]byte("a:\n  b: c\n  d: -500.5\ne: [3, 2, 1]\nf: null\n"))
+		if !Equal(jsonObject, yamlObject) {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("json and bson", func(t *testing.T) {
+		data, _ := bson.Marshal(bson.D{
+			{Name: "e", Value: []int64{3, 2, 1}},
+			{Name: "a", Value: bson.D{{Name: "d", Value: -500.5}, {Name: "b", Value: "c"}}},
+			{Name: "f", Value: nil},
+		})
+		if !Equal(jsonObject, NewFromBson(data)) {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("ordered and unordered maps", func(t *testing.T) {
+		ordered := New(bson.D{{Name: "x", Value: 1}, {Name: "y", Value: uint8(2)}})
+		if !Equal(ordered, New(map[string]interface{}{"y": 2.0, "x": int64(1)})) {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("json numbers", func(t *testing.T) {
+		var document interface{}
+		decoder := json.NewDecoder(strings.NewReader(`{"n":9007199254740993}`))
+		decoder.UseNumber()
+		_ = decoder.Decode(&document)
+		if !Equal(New(document), New(map[string]interface{}{"n": uint64(9007199254740993)})) {
+			t.Fatalf(`expect true`)
+		}
+		if Equal(New(document), New(map[string]interface{}{"n": int64(9007199254740992)})) {
+			t.Fatalf(`expect false for lossy float comparison`)
+		}
+	})
+
+	t.Run("large ids", func(t *testing.T) {
+		id := func(v interface{}) Object {
+			return New(map[string]interface{}{"id": v})
+		}
+		if Equal(id(int64(9007199254740993)), id(9007199254740992.0)) {
+			t.Fatalf(`expect false for lossy int and float comparison`)
+		}
+		if Equal(id(uint64(1<<64-1)), id(float64(1<<64))) {
+			t.Fatalf(`expect false for lossy uint and float comparison`)
+		}
+		if !Equal(id(int64(9007199254740992)), id(9007199254740992.0)) || !Equal(id(uint64(1<<63)), id(float64(1<<63))) {
+			t.Fatalf(`expect true for exact integer floats`)
+		}
+		if Equal(id(1), id(1.5)) || Equal(id(-1), id(-1.5)) || Equal(id(uint64(0)), id(-0.5)) {
+			t.Fatalf(`expect false for fractional floats`)
+		}
+	})
+
+	t.Run("different values", func(t *testing.T) {
+		if Equal(jsonObject, NewFromJson([]byte(`{"a":{"b":"c","d":-500},"e":[3,2,1],"f":null}`))) {
+			t.Fatalf(`expect false`)
+		}
+	})
+
+	t.Run("number and string", func(t *testing.T) {
+		if Equal(New(1), New("1")) {
+			t.Fatalf(`expect false`)
+		}
+	})
+
+	t.Run("not exists", func(t *testing.T) {
+		if !Equal(jsonObject.Get("x"), jsonObject.Get("y")) || Equal(jsonObject.Get("x"), jsonObject.Get("f")) {
+			t.Fatalf(`expect only not exists objects equal`)
+		}
+	})
+}
+
+func TestEqualWith(t *testing.T) {
+	a := NewFromJson([]byte(`{"id":1,"items":[{"id":1,"v":"a"},{"id":2,"v":"b"}],"tags":null}`))
+	b := NewFromJson([]byte(`{"id":2,"items":[{"id":5,"v":"a"},{"id":6,"v":"b"}],"tags":[]}`))
+
+	t.Run("without options", func(t *testing.T) {
+		if EqualWith(a, b, EqualOptions{}) {
+			t.Fatalf(`expect false`)
+		}
+	})
+
+	t.Run("ignore paths and nil as empty", func(t *testing.T) {
+		options := EqualOptions{IgnorePaths: []string{"id", "items[*].id"}, NilEqualsEmpty: true}
+		if !EqualWith(a, b, options) {
+			t.Fatalf(`expect true`)
+		}
+	})
+
+	t.Run("ignore missing key", func(t *testing.T) {
+		options := EqualOptions{IgnorePaths: []string{"x"}}
+		if !EqualWith(New(map[string]interface{}{"x": 1}), New(map[string]interface{}{}), options) {
+			t.Fatalf(`expect true`)
+		}
+	})
+}
@@ -3,20 +3,36 @@ package object
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 )
 
 const (
 	ErrorObjectNotExists = "object isn't exists"
 	ErrorTypeNotSupport  = "type isn't supporting"
 	ErrorFieldNotFound   = "field name not found"
+	ErrorKeyAmbiguous    = "key matches several fields"
 	ErrorIndexParse      = "index can't be parsed"
 	ErrorIndexRange      = "index out of range"
+	ErrorElementNotFound = "element not found"
 	ErrorDataParse       = "data can't be parsed"
+	ErrorKeyConflict     = "key conflicts with another key"
+	ErrorPathParse       = "path can't be parsed"
+	ErrorSelectionParse  = "selection can't be parsed"
+	ErrorPointerParse    = "json pointer can't be parsed"
+	ErrorPatchOperation  = "patch operation isn't supporting"
+	ErrorPatchTest       = "patch test operation failed"
+	ErrorNumberPrecision = "number can't be represented exactly"
 )
 
 // Error - objects manipulation error
 type Error struct {
 	err error
+	// Key - requested key of ErrorFieldNotFound and ErrorKeyAmbiguous.
+	Key string
+	// keys - available keys (unsorted) of ErrorFieldNotFound
+	// or matched keys of ErrorKeyAmbiguous.
+	keys []string
 }
 
 func newError(text string) *Error {
@@ -25,6 +41,43 @@ func newError(text string) *Error {
 	}
 }
 
+// newFieldError - ErrorFieldNotFound of the key in the map.
+// Keys of the map are copying on the miss, they are sorting and
+// suggesting only on demand to keep misses of Get cheap.
+func newFieldError(key string, container reflect.Value) *Error {
+	err := newError(ErrorFieldNotFound)
+	err.Key, err.keys = key, make([]string, 0, container.Len())
+	iter := container.MapRange()
+	for iter.Next() {
+		err.keys = append(err.keys, keyString(iter.Key()))
+	}
+	return err
+}
+
+// Keys - available keys (in ascending order) of ErrorFieldNotFound
+// or matched keys of ErrorKeyAmbiguous. Available keys are the keys
+// of the map at the moment of the miss.
+func (e *Error) Keys() []string {
+	if e == nil {
+		return nil
+	}
+	if e.err.Error() != ErrorFieldNotFound || e.keys == nil {
+		return e.keys
+	}
+	keys := append([]string(nil), e.keys...)
+	sort.Strings(keys)
+	return keys
+}
+
+// Suggestions - available keys closest to the requested one
+// (see Suggest) of ErrorFieldNotFound.
+func (e *Error) Suggestions() []string {
+	if e == nil || e.err.Error() != ErrorFieldNotFound || e.keys == nil {
+		return nil
+	}
+	return Suggest(e.Key, e.Keys())
+}
+
 func (e *Error) Error() string {
 	if e == nil {
 		return ""
@@ -32,6 +85,35 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%v", e.err)
 }
 
+// Hint - "did you mean" hint of ErrorFieldNotFound like
+// `"userName" not found, did you mean "username"?` or matched keys
+// of ErrorKeyAmbiguous. The error text is kept as is to be comparable
+// with the error constants.
+func (e *Error) Hint() string {
+	if e == nil {
+		return ""
+	}
+	switch e.err.Error() {
+	case ErrorKeyAmbiguous:
+		return fmt.Sprintf("%q matches %s", e.Key, QuoteJoin(e.Keys(), " and "))
+	case ErrorFieldNotFound:
+	default:
+		return ""
+	}
+	keys := e.Keys()
+	if suggestions := Suggest(e.Key, keys); len(suggestions) > 0 {
+		return fmt.Sprintf("%q not found, did you mean %s?", e.Key, QuoteJoin(suggestions, " or "))
+	}
+	more := ""
+	if len(keys) > hintKeysLimit {
+		keys, more = keys[:hintKeysLimit], fmt.Sprintf(" and %d more", len(keys)-hintKeysLimit)
+	}
+	return fmt.Sprintf("%q not found, available keys: %s%s", e.Key, QuoteJoin(keys, ", "), more)
+}
+
+// hintKeysLimit - max count of available keys listed by Hint.
+const hintKeysLimit = 10
+
 func (e *Error) Unwrap() error {
 	if e == nil {
 		return nil
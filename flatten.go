@@ -0,0 +1,285 @@
+package object
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FlattenOptions - options of flat keys representation.
+type FlattenOptions struct {
+	// Separator - separator of the keys, "." by default.
+	Separator string
+	// Brackets - represent slice indexes like `e[0]` instead of `e.0`.
+	Brackets bool
+}
+
+func (f FlattenOptions) separator() string {
+	if f.Separator == "" {
+		return "."
+	}
+	return f.Separator
+}
+
+// Flatten - make a flat map with keys joined by the separator,
+// like {"a.b": "c", "e.0": 3}. Backslashes and separators inside
+// the keys are escaping with backslash, empty keys are kept as empty
+// segments. Empty maps and slices are kept as values, so the result
+// can be turned back with Unflatten. Leaves with same flat key
+// produce ErrorKeyConflict.
+func (o Object) Flatten(separator string) Object {
+	return o.FlattenWith(FlattenOptions{Separator: separator})
+}
+
+// FlattenWith - acts like Flatten but with options.
+// With brackets notation indexes of empty keys follow the separator,
+// like `a..[0]` for {"a": {"": [1]}}.
+func (o Object) FlattenWith(options FlattenOptions) Object {
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	result := make(map[string]interface{})
+	switch document := plain(*o.val).(type) {
+	case map[string]interface{}, []interface{}:
+		if !isNilOrEmpty(document) {
+			if err := flattenPlain(result, "", true, false, document, options); err != nil {
+				return Object{nil, err}
+			}
+		}
+		return New(result)
+	}
+	return Object{nil, newError(ErrorTypeNotSupport)}
+}
+
+// flattenPlain - put leaves of the plain value into the result.
+// The root value has no prefix, so its empty keys are kept,
+// empty - the last key of the prefix is empty.
+func flattenPlain(result map[string]interface{}, prefix string, root, empty bool, v interface{}, options FlattenOptions) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) > 0 {
+			for key, value := range v {
+				if err := flattenPlain(result, joinFlatKey(prefix, root, escapeFlatKey(key, options), options), false, key == "", value, options); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			for i, value := range v {
+				key := strconv.Itoa(i)
+				if options.Brackets {
+					key = "[" + key + "]"
+					if empty {
+						// index of the empty key follows the separator, like `a..[0]`
+						key = options.separator() + key
+					}
+					key = prefix + key
+				} else {
+					key = joinFlatKey(prefix, root, key, options)
+				}
+				if err := flattenPlain(result, key, false, false, value, options); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if _, ok := result[prefix]; ok {
+		return newError(ErrorKeyConflict)
+	}
+	result[prefix] = v
+	return nil
+}
+
+func joinFlatKey(prefix string, root bool, key string, options FlattenOptions) string {
+	if root {
+		return key
+	}
+	return prefix + options.separator() + key
+}
+
+// escapeFlatKey - escape backslashes, separators and brackets of the key.
+func escapeFlatKey(key string, options FlattenOptions) string {
+	pairs := []string{`\`, `\\`, options.separator(), `\` + options.separator()}
+	if options.Brackets {
+		pairs = append(pairs, "[", `\[`)
+	}
+	return strings.NewReplacer(pairs...).Replace(key)
+}
+
+// Unflatten - turn the flat map made by Flatten back into nested
+// maps and slices. Maps with all keys from 0 to n-1 become slices.
+func (o Object) Unflatten(separator string) Object {
+	return o.UnflattenWith(FlattenOptions{Separator: separator})
+}
+
+// UnflattenWith - acts like Unflatten but with options.
+// With brackets notation only `[n]` keys become slice indexes,
+// they must be from 0 to n-1 without gaps, otherwise ErrorIndexRange
+// is returned.
+func (o Object) UnflattenWith(options FlattenOptions) Object {
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	flat, ok := plain(*o.val).(map[string]interface{})
+	if !ok {
+		return Object{nil, newError(ErrorTypeNotSupport)}
+	}
+
+	root := &flatNode{}
+	for _, key := range sortedKeys(flat) {
+		segments, err := splitFlatKey(key, options)
+		if err != nil {
+			return Object{nil, err}
+		}
+		if err := root.put(segments, flat[key]); err != nil {
+			return Object{nil, err}
+		}
+	}
+	result, err := root.build(options)
+	if err != nil {
+		return Object{nil, err}
+	}
+	return New(result)
+}
+
+// flatSegment - single key of the flat key.
+type flatSegment struct {
+	key   string
+	index bool
+}
+
+// splitFlatKey - split the flat key into unescaped segments.
+func splitFlatKey(key string, options FlattenOptions) ([]flatSegment, error) {
+	separator := options.separator()
+	segments := make([]flatSegment, 0, 4)
+	var current strings.Builder
+	pending := true // current segment is started and has to be added
+	for i := 0; i < len(key); {
+		switch {
+		case key[i] == '\\' && i+1 < len(key):
+			if strings.HasPrefix(key[i+1:], separator) {
+				current.WriteString(separator)
+				i += 1 + len(separator)
+			} else {
+				current.WriteByte(key[i+1])
+				i += 2
+			}
+			pending = true
+		case strings.HasPrefix(key[i:], separator):
+			if pending {
+				segments = append(segments, flatSegment{key: current.String()})
+			}
+			current.Reset()
+			pending = true
+			i += len(separator)
+		case options.Brackets && key[i] == '[':
+			end := strings.IndexByte(key[i:], ']')
+			if end < 0 || !isIndexKey(key[i+1:i+end]) {
+				return nil, newError(ErrorPathParse)
+			}
+			if pending && current.Len() > 0 {
+				segments = append(segments, flatSegment{key: current.String()})
+			}
+			current.Reset()
+			segments = append(segments, flatSegment{key: key[i+1 : i+end], index: true})
+			pending = false
+			i += end + 1
+		default:
+			current.WriteByte(key[i])
+			pending = true
+			i++
+		}
+	}
+	if pending {
+		segments = append(segments, flatSegment{key: current.String()})
+	}
+	return segments, nil
+}
+
+// flatNode - node of the tree building by Unflatten.
+type flatNode struct {
+	children map[string]*flatNode
+	indexed  bool
+	value    interface{}
+	leaf     bool
+}
+
+// put - put the value into the tree by the segments.
+func (n *flatNode) put(segments []flatSegment, value interface{}) error {
+	if len(segments) == 0 {
+		if n.leaf || n.children != nil {
+			return newError(ErrorKeyConflict)
+		}
+		n.leaf, n.value = true, value
+		return nil
+	}
+	if n.leaf {
+		return newError(ErrorKeyConflict)
+	}
+	segment := segments[0]
+	if n.children == nil {
+		n.children = make(map[string]*flatNode)
+		n.indexed = segment.index
+	}
+	n.indexed = n.indexed && segment.index
+	child, ok := n.children[segment.key]
+	if !ok {
+		child = &flatNode{}
+		n.children[segment.key] = child
+	}
+	return child.put(segments[1:], value)
+}
+
+// build - make the plain value of the node.
+// Bracket indexes must cover the slice without gaps, so huge or sparse
+// indexes from untrusted keys aren't allocating huge slices.
+func (n *flatNode) build(options FlattenOptions) (interface{}, error) {
+	if n.leaf {
+		return n.value, nil
+	}
+	if n.isSlice(options) {
+		s := make([]interface{}, len(n.children))
+		for key, child := range n.children {
+			index, err := strconv.Atoi(key)
+			if err != nil || index >= len(n.children) || (len(key) > 1 && key[0] == '0') {
+				return nil, newError(ErrorIndexRange)
+			}
+			value, err := child.build(options)
+			if err != nil {
+				return nil, err
+			}
+			s[index] = value
+		}
+		return s, nil
+	}
+	m := make(map[string]interface{}, len(n.children))
+	for key, child := range n.children {
+		value, err := child.build(options)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = value
+	}
+	return m, nil
+}
+
+// isSlice - check that the node's children are slice elements.
+func (n *flatNode) isSlice(options FlattenOptions) bool {
+	if options.Brackets {
+		return n.indexed
+	}
+	if len(n.children) == 0 {
+		return false
+	}
+	for key := range n.children {
+		if !isIndexKey(key) || (len(key) > 1 && key[0] == '0') {
+			return false
+		}
+		if index, err := strconv.Atoi(key); err != nil || index >= len(n.children) {
+			return false
+		}
+	}
+	return true
+}
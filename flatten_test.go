@@ -0,0 +1,115 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_Flatten_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{"a":{"b":"c","d.e":null},"e":[3,{"f":[]}],"g":{}}`))
+
+	cases := []struct {
+		name    string
+		options FlattenOptions
+		json    string
+	}{
+		{"dots", FlattenOptions{}, `{"a.b":"c","a.d\\.e":null,"e.0":3,"e.1.f":[],"g":{}}`},
+		{"brackets", FlattenOptions{Brackets: true}, `{"a.b":"c","a.d\\.e":null,"e[0]":3,"e[1].f":[],"g":{}}`},
+		{"custom separator", FlattenOptions{Separator: "__"}, `{"a__b":"c","a__d.e":null,"e__0":3,"e__1__f":[],"g":{}}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			flat := object.FlattenWith(c.options)
+			if data, _ := flat.ToCanonicalJson(); string(data) != c.json {
+				t.Fatalf(`expect %s, got: %s`, c.json, data)
+			}
+			if result := flat.UnflattenWith(c.options); !Equal(result, object) {
+				data, _ := result.ToJson()
+				t.Fatalf(`expect round trip, got: %s`, data)
+			}
+		})
+	}
+
+	t.Run("root slice", func(t *testing.T) {
+		flat := NewFromJson([]byte(`[{"a":1},[2]]`)).FlattenWith(FlattenOptions{Brackets: true})
+		if data, _ := flat.ToCanonicalJson(); string(data) != `{"[0].a":1,"[1][0]":2}` {
+			t.Fatalf(`expect {"[0].a":1,"[1][0]":2}, got: %s`, data)
+		}
+		if data, _ := flat.UnflattenWith(FlattenOptions{Brackets: true}).ToJson(); string(data) != `[{"a":1},[2]]` {
+			t.Fatalf(`expect [{"a":1},[2]], got: %s`, data)
+		}
+	})
+
+	t.Run("empty keys", func(t *testing.T) {
+		source := NewFromJson([]byte(`{"":{"b":1,"":[2]},"b":2}`))
+		if data, _ := source.FlattenWith(FlattenOptions{Brackets: true}).ToCanonicalJson(); string(data) != `{"..[0]":2,".b":1,"b":2}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+		for _, options := range []FlattenOptions{{}, {Brackets: true}} {
+			flat := source.FlattenWith(options)
+			if flat.Len() != 3 || flat.Get(".b").ToValue() != 1.0 || flat.Get("b").ToValue() != 2.0 {
+				t.Fatalf(`unexpected result: %v`, flat.ToValue())
+			}
+			if result := flat.UnflattenWith(options); !Equal(result, source) {
+				data, _ := result.ToJson()
+				t.Fatalf(`expect round trip, got: %s`, data)
+			}
+		}
+		nested := NewFromJson([]byte(`{"":[{"":[1]}],"a":{"":[2,{"":{}}]}}`))
+		flat := nested.FlattenWith(FlattenOptions{Brackets: true})
+		if result := flat.UnflattenWith(FlattenOptions{Brackets: true}); !Equal(result, nested) {
+			data, _ := flat.ToCanonicalJson()
+			t.Fatalf(`expect round trip of %s`, data)
+		}
+	})
+
+	t.Run("scalar unsupported", func(t *testing.T) {
+		if obj := object.GetPath("a.b").Flatten("."); obj.GetError().Error() != ErrorTypeNotSupport {
+			t.Fatalf(`expect ErrorTypeNotSupport, got: %v`, obj.GetError())
+		}
+	})
+}
+
+func TestObject_Unflatten_Json(t *testing.T) {
+	t.Run("numeric keys", func(t *testing.T) {
+		result := NewFromJson([]byte(`{"a.0":1,"a.1":2,"b.0":1,"b.2":2,"c.01":3}`)).Unflatten(".")
+		if data, _ := result.ToCanonicalJson(); string(data) != `{"a":[1,2],"b":{"0":1,"2":2},"c":{"01":3}}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	t.Run("brackets keep numeric keys", func(t *testing.T) {
+		result := NewFromJson([]byte(`{"a.0":1,"b[1]":2,"b[0]":1}`)).UnflattenWith(FlattenOptions{Brackets: true})
+		if data, _ := result.ToCanonicalJson(); string(data) != `{"a":{"0":1},"b":[1,2]}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	t.Run("key conflict", func(t *testing.T) {
+		result := NewFromJson([]byte(`{"a":1,"a.b":2}`)).Unflatten(".")
+		if result.GetError().Error() != ErrorKeyConflict {
+			t.Fatalf(`expect ErrorKeyConflict, got: %v`, result.GetError())
+		}
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		for _, data := range []string{
+			`{"a[9223372036854775807]":1}`,
+			`{"a[99999999999999999999]":1}`,
+			`{"a[100000000000]":1}`,
+			`{"a[0]":1,"a[2]":2}`,
+			`{"a[0]":1,"a[01]":2}`,
+		} {
+			result := NewFromJson([]byte(data)).UnflattenWith(FlattenOptions{Brackets: true})
+			if result.GetError() == nil || result.GetError().Error() != ErrorIndexRange {
+				t.Fatalf(`expect ErrorIndexRange for %s, got: %v`, data, result.GetError())
+			}
+		}
+	})
+
+	t.Run("bad index", func(t *testing.T) {
+		result := NewFromJson([]byte(`{"a[x]":1}`)).UnflattenWith(FlattenOptions{Brackets: true})
+		if result.GetError().Error() != ErrorPathParse {
+			t.Fatalf(`expect ErrorPathParse, got: %v`, result.GetError())
+		}
+	})
+}
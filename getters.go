@@ -31,12 +31,12 @@ func (o Object) Get(key string) Object {
 	case reflect.Map:
 		iter := val.MapRange()
 		for iter.Next() {
-			if iter.Key().String() == key {
-				v := iter.Value().Elem()
+			if keyString(iter.Key()) == key {
+				v := elem(iter.Value())
 				return Object{&v, nil}
 			}
 		}
-		return Object{nil, newError(ErrorFieldNotFound)}
+		return Object{nil, newFieldError(key, val)}
 	case reflect.Slice, reflect.Array:
 		index, err := strconv.ParseInt(key, 10, 64)
 		if err != nil {
@@ -60,7 +60,7 @@ func (o Object) GetIndex(index int) Object {
 		if index < 0 || index >= val.Len() {
 			return Object{nil, newError(ErrorIndexRange)}
 		}
-		v := val.Index(index).Elem()
+		v := elem(val.Index(index))
 		return Object{&v, nil}
 	}
 
@@ -3,6 +3,7 @@ package object
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -77,6 +78,65 @@ func TestObject_Get_Json(t *testing.T) {
 	})
 }
 
+func TestObject_Get_Suggestions(t *testing.T) {
+	object := NewFromJson([]byte(`{"username":"ann","userId":1,"email":"a@b.c","userNam":2}`))
+
+	t.Run("did you mean", func(t *testing.T) {
+		var err *Error
+		if !errors.As(object.Get("userName").GetError(), &err) {
+			t.Fatalf(`expect *Error, got: %v`, object.Get("userName").GetError())
+		}
+		if err.Error() != ErrorFieldNotFound {
+			t.Fatalf(`expect ErrorFieldNotFound, got: %v`, err)
+		}
+		if err.Key != "userName" || len(err.Keys()) != 4 || err.Keys()[0] != "email" {
+			t.Fatalf(`unexpected key details: %q %v`, err.Key, err.Keys())
+		}
+		if err.Hint() != `"userName" not found, did you mean "username" or "userNam"?` {
+			t.Fatalf(`unexpected hint: %s`, err.Hint())
+		}
+	})
+	t.Run("available keys", func(t *testing.T) {
+		err := object.Get("phone").GetError().(*Error)
+		if len(err.Suggestions()) != 0 || err.Hint() != `"phone" not found, available keys: "email", "userId", "userNam", "username"` {
+			t.Fatalf(`unexpected hint: %s`, err.Hint())
+		}
+	})
+	t.Run("many available keys", func(t *testing.T) {
+		m := map[string]int{"a, b": 0}
+		for i := 0; i < 20; i++ {
+			m[fmt.Sprintf("k%02d", i)] = i
+		}
+		hint := New(m).Get("x").GetError().(*Error).Hint()
+		if hint != `"x" not found, available keys: "a, b", "k00", "k01", "k02", "k03", "k04", "k05", "k06", "k07", "k08" and 11 more` {
+			t.Fatalf(`unexpected hint: %s`, hint)
+		}
+	})
+	t.Run("other errors", func(t *testing.T) {
+		if hint := object.Get("x").Get("y").GetError().(*Error).Hint(); hint != "" {
+			t.Fatalf(`unexpected hint: %s`, hint)
+		}
+	})
+	t.Run("keys at the miss", func(t *testing.T) {
+		m := map[string]interface{}{"username": 1}
+		err := New(m).Get("userName").GetError().(*Error)
+		m["userNam"] = 2
+		delete(m, "username")
+		if err.Hint() != `"userName" not found, did you mean "username"?` {
+			t.Fatalf(`unexpected hint: %s`, err.Hint())
+		}
+	})
+	t.Run("typed map", func(t *testing.T) {
+		typed := New(map[int]string{1: "a", 2: "b"})
+		if typed.Get("2").ToValue() != "b" {
+			t.Fatalf(`unexpected value: %v`, typed.Get("2").ToValue())
+		}
+		if err := typed.Get("3").GetError().(*Error); len(err.Suggestions()) != 2 {
+			t.Fatalf(`unexpected suggestions: %v`, err.Suggestions())
+		}
+	})
+}
+
 func TestObject_GetIndex_Json(t *testing.T) {
 	source := `[3, 2, { "a": 1, "b": 2 }]`
 	var document interface{}
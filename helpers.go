@@ -1,6 +1,20 @@
 package object
 
-import "reflect"
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/mgo.v2/bson"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	typeJsonNumber = reflect.TypeOf(json.Number(""))
+	typeBsonD      = reflect.TypeOf(bson.D{})
+)
 
 // deref - acts like *operator but in deep mode.
 func deref(v reflect.Value) reflect.Value {
@@ -10,3 +24,300 @@ func deref(v reflect.Value) reflect.Value {
 	}
 	return cpv
 }
+
+// unwrap - acts like deref but also unpacks interfaces.
+func unwrap(v reflect.Value) reflect.Value {
+	cpv := v
+	for cpv.Kind() == reflect.Ptr || cpv.Kind() == reflect.Interface {
+		cpv = cpv.Elem()
+	}
+	return cpv
+}
+
+// elem - unpack the interface of container's element if it is.
+func elem(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Interface {
+		return v.Elem()
+	}
+	return v
+}
+
+// isBytes - check that the value is a byte slice or array,
+// such values are considering as scalars (like BSON binary data).
+func isBytes(v reflect.Value) bool {
+	return (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() == reflect.Uint8
+}
+
+// keyString - string representation of a map key.
+func keyString(key reflect.Value) string {
+	key = unwrap(key)
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+	if !key.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+// plain - deep copy of the value into plain data containers:
+// map[string]interface{}, []interface{} and scalars.
+// Ordered bson.D documents are converting to maps too.
+// Byte slices are kept as is.
+func plain(v reflect.Value) interface{} {
+	v = unwrap(v)
+	if v.IsValid() && v.Type() == typeBsonD {
+		m := make(map[string]interface{}, v.Len())
+		for _, elem := range v.Interface().(bson.D) {
+			m[elem.Name] = plain(reflect.ValueOf(elem.Value))
+		}
+		return m
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[keyString(iter.Key())] = plain(iter.Value())
+		}
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		if isBytes(v) {
+			return append([]byte{}, v.Bytes()...)
+		}
+		fallthrough
+	case reflect.Array:
+		s := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s[i] = plain(v.Index(i))
+		}
+		return s
+	}
+	if !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// copyPlain - deep copy of a plain data value.
+func copyPlain(v interface{}) interface{} {
+	return plain(reflect.ValueOf(v))
+}
+
+// sortedKeys - keys of the plain map in ascending order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// number - numeric representation of the value if it's a number.
+// Integers are returned as exact int64/uint64 parts to avoid losses.
+type number struct {
+	kind  reflect.Kind // reflect.Int64, reflect.Uint64 or reflect.Float64
+	int   int64
+	uint  uint64
+	float float64
+}
+
+// toNumber - try to represent the value as a number.
+// Strings aren't numbers here, except the json.Number.
+func toNumber(v reflect.Value) (number, bool) {
+	v = unwrap(v)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: reflect.Int64, int: v.Int(), float: float64(v.Int())}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: reflect.Uint64, uint: v.Uint(), float: float64(v.Uint())}, true
+	case reflect.Float32, reflect.Float64:
+		return number{kind: reflect.Float64, float: v.Float()}, true
+	case reflect.String:
+		if v.Type() != typeJsonNumber {
+			return number{}, false
+		}
+		if i, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return number{kind: reflect.Int64, int: i, float: float64(i)}, true
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return number{kind: reflect.Uint64, uint: u, float: float64(u)}, true
+		}
+		if f, err := strconv.ParseFloat(v.String(), 64); err == nil {
+			return number{kind: reflect.Float64, float: f}, true
+		}
+	}
+	return number{}, false
+}
+
+// exact - exact decimal representation of the number. Integer floats
+// are represented like integers, so equal numbers have same representation.
+func (n number) exact() string {
+	switch n.kind {
+	case reflect.Int64:
+		return strconv.FormatInt(n.int, 10)
+	case reflect.Uint64:
+		return strconv.FormatUint(n.uint, 10)
+	}
+	if n.float == math.Trunc(n.float) && math.Abs(n.float) < 1<<63 {
+		return strconv.FormatInt(int64(n.float), 10)
+	}
+	if n.float == math.Trunc(n.float) && n.float > 0 && n.float < 1<<64 {
+		return strconv.FormatUint(uint64(n.float), 10)
+	}
+	return strconv.FormatFloat(n.float, 'g', -1, 64)
+}
+
+// isFloat - check that float64 represents the number exactly.
+func (n number) isFloat() bool {
+	switch n.kind {
+	case reflect.Int64:
+		return n.float < 1<<63 && int64(n.float) == n.int
+	case reflect.Uint64:
+		return n.float < 1<<64 && uint64(n.float) == n.uint
+	}
+	return true
+}
+
+// compare - compares two numbers, returns -1, 0 or 1.
+// Integers and floats are comparing exactly, without conversion to float64.
+// NaN is less than any other number and equal to itself, like in BSON.
+func (n number) compare(m number) int {
+	if n.kind != reflect.Float64 && m.kind != reflect.Float64 {
+		switch {
+		case n.kind == reflect.Int64 && m.kind == reflect.Int64:
+			return compareInt64(n.int, m.int)
+		case n.kind == reflect.Uint64 && m.kind == reflect.Uint64:
+			return compareUint64(n.uint, m.uint)
+		case n.kind == reflect.Int64:
+			if n.int < 0 {
+				return -1
+			}
+			return compareUint64(uint64(n.int), m.uint)
+		default:
+			if m.int < 0 {
+				return 1
+			}
+			return compareUint64(n.uint, uint64(m.int))
+		}
+	}
+	switch nan, mnan := math.IsNaN(n.float), math.IsNaN(m.float); {
+	case nan && mnan:
+		return 0
+	case nan:
+		return -1
+	case mnan:
+		return 1
+	case n.kind != reflect.Float64:
+		return -m.compareInteger(n)
+	case m.kind != reflect.Float64:
+		return n.compareInteger(m)
+	case n.float < m.float:
+		return -1
+	case n.float > m.float:
+		return 1
+	}
+	return 0
+}
+
+// compareInteger - compare the float number with the integer number m.
+func (n number) compareInteger(m number) int {
+	whole := math.Trunc(n.float)
+	var result int
+	if m.kind == reflect.Int64 {
+		switch {
+		case whole < -1<<63:
+			return -1
+		case whole >= 1<<63:
+			return 1
+		}
+		result = compareInt64(int64(whole), m.int)
+	} else {
+		switch {
+		case whole < 0:
+			return -1
+		case whole >= 1<<64:
+			return 1
+		}
+		result = compareUint64(uint64(whole), m.uint)
+	}
+	if result == 0 && n.float != whole {
+		if n.float > whole {
+			return 1
+		}
+		return -1
+	}
+	return result
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// equalPlain - deep equality of plain data values
+// with numbers comparing by their values.
+func equalPlain(a, b interface{}) bool {
+	if na, ok := toNumber(reflect.ValueOf(a)); ok {
+		if nb, ok := toNumber(reflect.ValueOf(b)); ok {
+			return na.compare(nb) == 0
+		}
+		return false
+	}
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := b[k]
+			if !ok || !equalPlain(av, bv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equalPlain(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
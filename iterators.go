@@ -0,0 +1,97 @@
+package object
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// Iterators are plain functions compatible with iter.Seq and iter.Seq2,
+// so with Go 1.23+ they can be used in range loops:
+//
+//	for key, value := range obj.All() {
+//		...
+//	}
+//
+// Unlike GetKeys, GetValues and GetEntries they don't collect the whole
+// result, so breaking the loop early stops the iteration.
+
+// All - iterator over key-values of reflect.Map or reflect.Slice.
+// For reflect.Map order of entries is not guaranteeing.
+// Byte slices are considering as scalars and have no entries.
+func (o Object) All() func(yield func(key string, value Object) bool) {
+	return func(yield func(string, Object) bool) {
+		o.iterate(yield)
+	}
+}
+
+// Keys - iterator over keys of reflect.Map or indexes of reflect.Slice.
+// For reflect.Map order of keys is not guaranteeing.
+func (o Object) Keys() func(yield func(key string) bool) {
+	return func(yield func(string) bool) {
+		o.iterate(func(key string, _ Object) bool {
+			return yield(key)
+		})
+	}
+}
+
+// Values - iterator over values of reflect.Map or reflect.Slice.
+// For reflect.Map order of values is not guaranteeing.
+func (o Object) Values() func(yield func(value Object) bool) {
+	return func(yield func(Object) bool) {
+		o.iterate(func(_ string, value Object) bool {
+			return yield(value)
+		})
+	}
+}
+
+// Descendants - recursive depth-first iterator over all nested objects
+// with their paths relative to the object. The object itself isn't yielded.
+// The path is reused between steps, so copy it if it's needed later.
+func (o Object) Descendants() func(yield func(path Path, value Object) bool) {
+	return func(yield func(Path, Object) bool) {
+		path := make(Path, 0, 16)
+		o.descend(&path, yield)
+	}
+}
+
+// descend - yield children of the object and their descendants.
+// Returns false if the iteration was stopped.
+func (o Object) descend(path *Path, yield func(Path, Object) bool) bool {
+	return o.iterate(func(key string, value Object) bool {
+		*path = append(*path, key)
+		ok := yield(*path, value) && value.descend(path, yield)
+		*path = (*path)[:len(*path)-1]
+		return ok
+	})
+}
+
+// iterate - call yield for each entry until it returns false.
+// Returns false if the iteration was stopped.
+func (o Object) iterate(yield func(string, Object) bool) bool {
+	if !o.IsExists() {
+		return true
+	}
+
+	val := unwrap(*o.val)
+	switch val.Kind() {
+	case reflect.Map:
+		iter := val.MapRange()
+		for iter.Next() {
+			v := elem(iter.Value())
+			if !yield(keyString(iter.Key()), Object{&v, nil}) {
+				return false
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if isBytes(val) {
+			return true
+		}
+		for i := 0; i < val.Len(); i++ {
+			v := elem(val.Index(i))
+			if !yield(strconv.Itoa(i), Object{&v, nil}) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package object
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestObject_Iterators_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{"a":{"b":"c","d":[1,2]},"e":[3,2,1]}`))
+
+	t.Run("all slice", func(t *testing.T) {
+		keys := make([]string, 0, 3)
+		values := make([]interface{}, 0, 3)
+		object.Get("e").All()(func(key string, value Object) bool {
+			keys = append(keys, key)
+			values = append(values, value.ToValue())
+			return true
+		})
+		if !reflect.DeepEqual(keys, []string{"0", "1", "2"}) || !reflect.DeepEqual(values, []interface{}{3.0, 2.0, 1.0}) {
+			t.Fatalf(`expect [0 1 2] and [3 2 1], got: %v and %v`, keys, values)
+		}
+	})
+
+	t.Run("keys map", func(t *testing.T) {
+		keys := make([]string, 0, 2)
+		object.Get("a").Keys()(func(key string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		sort.Strings(keys)
+		if !reflect.DeepEqual(keys, []string{"b", "d"}) {
+			t.Fatalf(`expect [b d], got: %v`, keys)
+		}
+	})
+
+	t.Run("values early exit", func(t *testing.T) {
+		visited := 0
+		object.Get("e").Values()(func(value Object) bool {
+			visited++
+			return value.ToValue() != 2.0
+		})
+		if visited != 2 {
+			t.Fatalf(`expect 2 visited values, got: %v`, visited)
+		}
+	})
+
+	t.Run("not exists and scalars", func(t *testing.T) {
+		visited := 0
+		count := func(string, Object) bool {
+			visited++
+			return true
+		}
+		object.Get("x").All()(count)
+		object.GetPath("a.b").All()(count)
+		New([]byte("bytes")).All()(count)
+		if visited != 0 {
+			t.Fatalf(`expect no entries, got: %v`, visited)
+		}
+	})
+
+	t.Run("descendants", func(t *testing.T) {
+		paths := make([]string, 0, 8)
+		object.Descendants()(func(path Path, value Object) bool {
+			paths = append(paths, path.String())
+			return true
+		})
+		sort.Strings(paths)
+		control := []string{"a", "a.b", "a.d", "a.d[0]", "a.d[1]", "e", "e[0]", "e[1]", "e[2]"}
+		if !reflect.DeepEqual(paths, control) {
+			t.Fatalf(`expect %v, got: %v`, control, paths)
+		}
+	})
+
+	t.Run("descendants early exit", func(t *testing.T) {
+		visited := 0
+		object.Get("e").Descendants()(func(path Path, value Object) bool {
+			visited++
+			return path.String() != "[1]"
+		})
+		if visited != 2 {
+			t.Fatalf(`expect 2 visited values, got: %v`, visited)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package object
+
+import "reflect"
+
+// JoinKind - kind of Join.
+type JoinKind int
+
+const (
+	// JoinInner - only pairs of matched elements.
+	JoinInner JoinKind = iota
+	// JoinLeft - pairs of matched elements and unmatched left elements.
+	JoinLeft
+	// JoinFull - pairs of matched elements and unmatched elements of both sides.
+	JoinFull
+)
+
+// Join - join slices of maps by equality of values at the paths
+// (ParsePath syntax), like SQL joins do. Every pair of matched elements
+// produces a map with fields of both, right values overwrite left ones
+// for same keys (like JavaScript's Object.assign). Values are comparing
+// like in Equal, null and not exists values don't match anything.
+// Result order follows the left slice, then unmatched right elements.
+// The right slice is indexing once, so it takes O(n+m) for unique keys.
+func Join(left, right Object, leftPath, rightPath string, kind JoinKind) Object {
+	leftElements, leftKeys, err := left.elementsAt(leftPath)
+	if err != nil {
+		return Object{nil, err}
+	}
+	rightElements, rightKeys, err := right.elementsAt(rightPath)
+	if err != nil {
+		return Object{nil, err}
+	}
+	if !allMaps(leftElements) || !allMaps(rightElements) {
+		return Object{nil, newError(ErrorTypeNotSupport)}
+	}
+
+	index := make(map[string][]int, len(rightElements))
+	for i, key := range rightKeys {
+		if key.IsExists() && !key.IsNil() {
+			k := contentKey(key)
+			index[k] = append(index[k], i)
+		}
+	}
+
+	result := make([]interface{}, 0, len(leftElements))
+	matched := make([]bool, len(rightElements))
+	for i, key := range leftKeys {
+		var matches []int
+		if key.IsExists() && !key.IsNil() {
+			matches = index[contentKey(key)]
+		}
+		for _, j := range matches {
+			matched[j] = true
+			result = append(result, joinMaps(leftElements[i], rightElements[j]))
+		}
+		if len(matches) == 0 && kind != JoinInner {
+			result = append(result, joinMaps(leftElements[i], nil))
+		}
+	}
+	if kind == JoinFull {
+		for j, element := range rightElements {
+			if !matched[j] {
+				result = append(result, joinMaps(nil, element))
+			}
+		}
+	}
+	return New(result)
+}
+
+// allMaps - check that all the values are maps.
+func allMaps(values []interface{}) bool {
+	for _, value := range values {
+		if New(value).kind() != reflect.Map {
+			return false
+		}
+	}
+	return true
+}
+
+// joinMaps - shallow merge of maps, b values overwrite a ones.
+func joinMaps(a, b interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, value := range []interface{}{a, b} {
+		if value == nil {
+			continue
+		}
+		New(value).iterate(func(key string, value Object) bool {
+			result[key] = value.ToValue()
+			return true
+		})
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestJoin_Json(t *testing.T) {
+	users := NewFromJson([]byte(`[
+		{"id":1,"name":"ann"},
+		{"id":2,"name":"bob"},
+		{"id":3,"name":"eve"},
+		{"name":"nobody"}
+	]`))
+	orders := NewFromYaml([]byte(`
+- {order: 10, user: {id: 1}, total: 5}
+- {order: 11, user: {id: 3}, total: 7}
+- {order: 12, user: {id: 1}, total: 1}
+- {order: 13, user: {id: 9}, total: 2}
+`))
+
+	join := func(kind JoinKind) string {
+		result := Join(users, orders, "id", "user.id", kind).Map(func(entry Entry) interface{} {
+			name, order := entry.Value.Get("name").ToValue(), entry.Value.Get("order").ToValue()
+			if name == nil {
+				name = "-"
+			}
+			if order == nil {
+				order = "-"
+			}
+			return name.(string) + ":" + groupKey(New(order))
+		})
+		data, _ := result.ToJson()
+		return string(data)
+	}
+
+	t.Run("inner", func(t *testing.T) {
+		if result := join(JoinInner); result != `["ann:10","ann:12","eve:11"]` {
+			t.Fatalf(`unexpected result: %s`, result)
+		}
+	})
+
+	t.Run("left", func(t *testing.T) {
+		if result := join(JoinLeft); result != `["ann:10","ann:12","bob:-","eve:11","nobody:-"]` {
+			t.Fatalf(`unexpected result: %s`, result)
+		}
+	})
+
+	t.Run("full", func(t *testing.T) {
+		if result := join(JoinFull); result != `["ann:10","ann:12","bob:-","eve:11","nobody:-","-:13"]` {
+			t.Fatalf(`unexpected result: %s`, result)
+		}
+	})
+
+	t.Run("merged fields", func(t *testing.T) {
+		first := Join(users, orders, "id", "user.id", JoinInner).First()
+		data, _ := first.ToCanonicalJson()
+		if string(data) != `{"id":1,"name":"ann","order":10,"total":5,"user":{"id":1}}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	t.Run("large ids", func(t *testing.T) {
+		left := New([]interface{}{
+			map[string]interface{}{"id": int64(9007199254740993), "name": "ann"},
+			map[string]interface{}{"id": "9007199254740992", "name": "bob"},
+		})
+		right := New([]interface{}{
+			map[string]interface{}{"user": int64(9007199254740992), "order": 10},
+			map[string]interface{}{"user": int64(9007199254740993), "order": 11},
+		})
+		result := Join(left, right, "id", "user", JoinInner)
+		if result.Len() != 1 || result.First().Get("order").ToValue() != 11 {
+			t.Fatalf(`unexpected result: %v`, result.ToValue())
+		}
+	})
+
+	t.Run("not maps", func(t *testing.T) {
+		if obj := Join(users, New([]int{1}), "id", "", JoinInner); obj.GetError().Error() != ErrorTypeNotSupport {
+			t.Fatalf(`expect ErrorTypeNotSupport, got: %v`, obj.GetError())
+		}
+	})
+}
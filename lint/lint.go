@@ -0,0 +1,199 @@
+// Package lint reports unknown and deprecated keys of objects, like typos
+// in config files which are silently ignoring by decoders:
+//
+//	linter := lint.FromStruct(Config{}, "yaml")
+//	linter.Deprecate("server.addr", "server.host")
+//	for _, issue := range linter.Lint(object.NewFromData(data)) {
+//		fmt.Println(issue) // unknown key "server.prot", did you mean "port"?
+//	}
+//
+// Known keys are taken from a struct type (by tags) or a JSON Schema.
+package lint
+
+import (
+	"fmt"
+	"github.com/the-go-tool/object"
+	"regexp"
+	"sort"
+)
+
+// Issue - unknown or deprecated key.
+type Issue struct {
+	// Path - path of the key.
+	Path object.Path
+	// Deprecated - the key is known but deprecated, otherwise it's unknown.
+	Deprecated bool
+	// Suggestions - known keys similar to the unknown key.
+	Suggestions []string
+	// Replacement - hint for the deprecated key, like a new key path.
+	Replacement string
+}
+
+func (i Issue) String() string {
+	if i.Deprecated {
+		if i.Replacement == "" {
+			return fmt.Sprintf("deprecated key %q", i.Path.String())
+		}
+		return fmt.Sprintf("deprecated key %q, use %q instead", i.Path.String(), i.Replacement)
+	}
+	if len(i.Suggestions) == 0 {
+		return fmt.Sprintf("unknown key %q", i.Path.String())
+	}
+	return fmt.Sprintf("unknown key %q, did you mean %s?", i.Path.String(), object.QuoteJoin(i.Suggestions, " or "))
+}
+
+// Linter - known keys tree.
+type Linter struct {
+	root *node
+}
+
+// node - known keys of a value.
+type node struct {
+	// open - any keys are allowed in the value.
+	open bool
+	// fields - known keys of the map.
+	fields map[string]*node
+	// patterns - known patterns of the map keys.
+	patterns []pattern
+	// additional - keys aren't matching fields or patterns,
+	// they are unknown if it's nil.
+	additional *node
+	// items - elements of the slice.
+	items *node
+	// deprecated - the key of the value is deprecated.
+	deprecated  bool
+	replacement string
+}
+
+type pattern struct {
+	re   *regexp.Regexp
+	node *node
+}
+
+func openNode() *node {
+	return &node{open: true}
+}
+
+// copy - copy of the node which children can be changed.
+func (n *node) copy() *node {
+	copied := *n
+	copied.fields = nil
+	if n.fields != nil {
+		copied.fields = make(map[string]*node, len(n.fields))
+		for key, child := range n.fields {
+			copied.fields[key] = child
+		}
+	}
+	copied.patterns = append([]pattern(nil), n.patterns...)
+	return &copied
+}
+
+// Deprecate - mark the key at the path (ParsePath syntax) as deprecated with
+// the replacement hint. Key "*" means any map key or slice element.
+// Not known keys become known, so removed keys can be deprecated too.
+func (l *Linter) Deprecate(path, replacement string) error {
+	keys, err := object.ParsePath(path)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("lint: can't deprecate the root")
+	}
+	// nodes are shared by references and recursive types,
+	// so the nodes of the path are copied before the change
+	root := l.root.copy()
+	n := root
+	for _, key := range keys {
+		switch {
+		case key == "*" && n.items != nil:
+			n.items = n.items.copy()
+			n = n.items
+		case key == "*" && n.additional != nil:
+			n.additional = n.additional.copy()
+			n = n.additional
+		case key == "*":
+			return fmt.Errorf("lint: path %q doesn't match known keys", path)
+		default:
+			if n.fields == nil {
+				n.fields = make(map[string]*node)
+			}
+			child := openNode()
+			if n.fields[key] != nil {
+				child = n.fields[key].copy()
+			}
+			n.fields[key] = child
+			n = child
+		}
+	}
+	n.deprecated, n.replacement = true, replacement
+	l.root = root
+	return nil
+}
+
+// Lint - find unknown and deprecated keys of the object ordered by paths.
+func (l *Linter) Lint(obj object.Object) []Issue {
+	issues := make([]Issue, 0)
+	lint(l.root, obj, object.Path{}, &issues)
+	return issues
+}
+
+func lint(n *node, obj object.Object, path object.Path, issues *[]Issue) {
+	if n == nil || n.open && n.fields == nil && n.items == nil && n.additional == nil {
+		return
+	}
+	entries := make([]object.Entry, 0)
+	obj.All()(func(key string, value object.Object) bool {
+		entries = append(entries, object.Entry{Key: key, Value: value})
+		return true
+	})
+	if obj.IsSlice() {
+		for _, entry := range entries {
+			lint(n.items, entry.Value, path.Append(entry.Key), issues)
+		}
+		return
+	}
+	if !obj.IsMap() {
+		return
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	for _, entry := range entries {
+		child := n.child(entry.Key)
+		if child == nil {
+			if !n.open {
+				*issues = append(*issues, Issue{Path: path.Append(entry.Key), Suggestions: object.Suggest(entry.Key, n.known())})
+			}
+			continue
+		}
+		if child.deprecated {
+			*issues = append(*issues, Issue{Path: path.Append(entry.Key), Deprecated: true, Replacement: child.replacement})
+		}
+		lint(child, entry.Value, path.Append(entry.Key), issues)
+	}
+}
+
+// child - node of the map key, nil if it's unknown.
+func (n *node) child(key string) *node {
+	if child, ok := n.fields[key]; ok {
+		return child
+	}
+	for _, p := range n.patterns {
+		if p.re.MatchString(key) {
+			return p.node
+		}
+	}
+	return n.additional
+}
+
+// known - not deprecated known keys.
+func (n *node) known() []string {
+	keys := make([]string, 0, len(n.fields))
+	for key, child := range n.fields {
+		if !child.deprecated {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,185 @@
+package lint
+
+import (
+	"github.com/the-go-tool/object"
+	"strings"
+	"testing"
+)
+
+// issues - issues as lines.
+func issues(list []Issue) string {
+	lines := make([]string, 0, len(list))
+	for _, issue := range list {
+		lines = append(lines, issue.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+type server struct {
+	Host    string `yaml:"host"`
+	Port    int    `yaml:"port"`
+	Addr    string `yaml:"addr" deprecated:"server.host"`
+	private int
+}
+
+type base struct {
+	Name string `yaml:"name"`
+}
+
+type tree struct {
+	Name     string `yaml:"name"`
+	Children []tree `yaml:"children"`
+}
+
+type config struct {
+	base     `yaml:",inline"`
+	Server   *server                `yaml:"server"`
+	Backends []server               `yaml:"backends"`
+	Labels   map[string]string      `yaml:"labels"`
+	Limits   map[string]server      `yaml:"limits"`
+	Extra    interface{}            `yaml:"extra"`
+	Ignored  string                 `yaml:"-"`
+	Children []*config              `yaml:"children"`
+	Raw      map[string]interface{} `yaml:"raw,omitempty"`
+}
+
+func TestFromStruct(t *testing.T) {
+	linter := FromStruct(config{}, "yaml")
+	if err := linter.Deprecate("timeout", "server.timeout"); err != nil {
+		t.Fatalf(`unexpected error: %v`, err)
+	}
+	if err := linter.Deprecate("backends[*].host", ""); err != nil {
+		t.Fatalf(`unexpected error: %v`, err)
+	}
+	if err := linter.Deprecate("name[*]", ""); err == nil {
+		t.Fatalf(`expected error`)
+	}
+
+	obj := object.NewFromYaml([]byte(`
+name: app
+nmae: typo
+timeout: 5
+server: {host: a, prot: 80, addr: b, private: 1}
+backends:
+  - {host: a, port: 1}
+  - {hots: b}
+labels: {any: key}
+limits:
+  x: {port: 1, Port: 2}
+extra: {anything: {goes: here}}
+Ignored: x
+children:
+  - name: child
+    servre: {}
+raw: {a: 1}
+`))
+	expected := strings.Join([]string{
+		`unknown key "Ignored"`,
+		`deprecated key "backends[0].host"`,
+		`unknown key "backends[1].hots"`,
+		`unknown key "children[0].servre", did you mean "server"?`,
+		`unknown key "limits.x.Port", did you mean "port"?`,
+		`unknown key "nmae", did you mean "name"?`,
+		`deprecated key "server.addr", use "server.host" instead`,
+		`unknown key "server.private"`,
+		`unknown key "server.prot", did you mean "port"?`,
+		`deprecated key "timeout", use "server.timeout" instead`,
+	}, "\n")
+	if result := issues(linter.Lint(obj)); result != expected {
+		t.Fatalf(`unexpected issues: %s`, result)
+	}
+
+	t.Run("recursive type", func(t *testing.T) {
+		linter := FromStruct(tree{}, "yaml")
+		if err := linter.Deprecate("children[*].name", ""); err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		obj := object.NewFromYaml([]byte("name: a\nchildren: [{name: b, children: [{name: c}]}]\n"))
+		if result := issues(linter.Lint(obj)); result != `deprecated key "children[0].name"` {
+			t.Fatalf(`unexpected issues: %s`, result)
+		}
+	})
+}
+
+func TestFromSchema(t *testing.T) {
+	linter, err := FromSchema(object.NewFromJson([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"old": {"deprecated": true, "description": "name"},
+			"node": {"$ref": "#/$defs/node"},
+			"tags": {"type": "array", "items": {"properties": {"key": {}}, "additionalProperties": false}}
+		},
+		"patternProperties": {"^x-": {}},
+		"allOf": [{"properties": {"version": {"type": "integer"}}}],
+		"$defs": {
+			"node": {
+				"properties": {"value": {}, "next": {"$ref": "#/$defs/node"}},
+				"additionalProperties": false
+			}
+		}
+	}`)))
+	if err != nil {
+		t.Fatalf(`unexpected error: %v`, err)
+	}
+	obj := object.NewFromJson([]byte(`{
+		"name": "a", "old": 1, "x-custom": {"any": 1}, "verison": 2, "nome": 3,
+		"node": {"value": 1, "next": {"value": 2, "nxet": null}},
+		"tags": [{"key": 1}, {"kye": 2}]
+	}`))
+	expected := strings.Join([]string{
+		`unknown key "node.next.nxet", did you mean "next"?`,
+		`unknown key "nome", did you mean "name" or "node"?`,
+		`deprecated key "old", use "name" instead`,
+		`unknown key "tags[1].kye", did you mean "key"?`,
+		`unknown key "verison", did you mean "version"?`,
+	}, "\n")
+	if result := issues(linter.Lint(obj)); result != expected {
+		t.Fatalf(`unexpected issues: %s`, result)
+	}
+
+	t.Run("shared references", func(t *testing.T) {
+		linter, err := FromSchema(object.NewFromJson([]byte(`{
+			"properties": {"a": {"$ref": "#/$defs/x"}, "b": {"$ref": "#/$defs/x"}},
+			"allOf": [{"properties": {"a": {"properties": {"extra": {}}}}}],
+			"$defs": {"x": {"properties": {"value": {}, "next": {"$ref": "#/$defs/x"}}}}
+		}`)))
+		if err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		obj := object.NewFromJson([]byte(`{"a": {"value": 1, "extra": 2}, "b": {"value": 1, "extra": 2, "next": {"extra": 3}}}`))
+		expected := "unknown key \"b.extra\"\nunknown key \"b.next.extra\""
+		if result := issues(linter.Lint(obj)); result != expected {
+			t.Fatalf(`unexpected issues: %s`, result)
+		}
+	})
+
+	t.Run("deprecate shared reference", func(t *testing.T) {
+		linter, err := FromSchema(object.NewFromJson([]byte(`{
+			"properties": {"a": {"$ref": "#/$defs/x"}, "c": {"$ref": "#/$defs/x"}},
+			"$defs": {"x": {"properties": {"b": {}}}}
+		}`)))
+		if err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		if err := linter.Deprecate("a.b", "a.d"); err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		obj := object.NewFromJson([]byte(`{"a": {"b": 1}, "c": {"b": 2}}`))
+		if result := issues(linter.Lint(obj)); result != `deprecated key "a.b", use "a.d" instead` {
+			t.Fatalf(`unexpected issues: %s`, result)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, schema := range []string{
+			`{"$ref": "other.json"}`,
+			`{"$ref": "#/$defs/missing"}`,
+			`{"patternProperties": {"(": {}}}`,
+		} {
+			if _, err := FromSchema(object.NewFromJson([]byte(schema))); err == nil {
+				t.Fatalf(`expected error for %s`, schema)
+			}
+		}
+	})
+}
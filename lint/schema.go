@@ -0,0 +1,259 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/the-go-tool/object"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// FromSchema - linter of keys known by the JSON Schema object.
+// Keys are known from "properties", "patternProperties" and
+// "additionalProperties" (unless it's false), also through local "$ref",
+// "allOf", "anyOf", "oneOf", "then" and "else". Objects without any of
+// these keywords allow any keys. Keys with "deprecated": true
+// are deprecated, their "description" is the replacement hint.
+// Unlike the schema package, only local JSON Pointer references
+// like "#/$defs/name" are resolving, references to anchors, "$id"
+// and files are returning an error.
+func FromSchema(schema object.Object) (*Linter, error) {
+	data, err := schema.ToJson()
+	if err != nil {
+		return nil, err
+	}
+	var root interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	builder := schemaBuilder{root: root, refs: make(map[string]*node)}
+	n, err := builder.ref("#")
+	if err != nil {
+		return nil, err
+	}
+	return &Linter{root: n}, nil
+}
+
+type schemaBuilder struct {
+	root interface{}
+	refs map[string]*node
+}
+
+// keyKeywords - keywords describing keys of the values.
+var keyKeywords = []string{"properties", "patternProperties", "additionalProperties", "items", "additionalItems", "prefixItems", "allOf", "anyOf", "oneOf", "then", "else"}
+
+func (b schemaBuilder) build(schema interface{}) (*node, error) {
+	if m, ok := schema.(map[string]interface{}); ok {
+		if ref, ok := m["$ref"].(string); ok && !hasAny(m, keyKeywords) {
+			return b.ref(ref)
+		}
+	}
+	n := &node{}
+	if err := b.add(n, schema); err != nil {
+		return nil, err
+	}
+	if n.fields == nil && n.patterns == nil && n.additional == nil {
+		n.open = true
+	}
+	return n, nil
+}
+
+// add - add known keys of the schema to the node.
+func (b schemaBuilder) add(n *node, schema interface{}) error {
+	m, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if ref, ok := m["$ref"].(string); ok {
+		target, err := b.ref(ref)
+		if err != nil {
+			return err
+		}
+		merge(n, target)
+	}
+
+	if properties, ok := m["properties"].(map[string]interface{}); ok {
+		if n.fields == nil {
+			n.fields = make(map[string]*node)
+		}
+		for key, property := range properties {
+			child, err := b.build(property)
+			if err != nil {
+				return err
+			}
+			if p, ok := property.(map[string]interface{}); ok && p["deprecated"] == true {
+				copied := *child
+				copied.deprecated = true
+				copied.replacement, _ = p["description"].(string)
+				child = &copied
+			}
+			if existing, ok := n.fields[key]; ok {
+				n.fields[key] = merged(existing, child)
+			} else {
+				n.fields[key] = child
+			}
+		}
+	}
+	if patterns, ok := m["patternProperties"].(map[string]interface{}); ok {
+		for expr, property := range patterns {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return fmt.Errorf("lint: pattern %q: %w", expr, err)
+			}
+			child, err := b.build(property)
+			if err != nil {
+				return err
+			}
+			n.patterns = append(n.patterns, pattern{re, child})
+		}
+	}
+	if additional, ok := m["additionalProperties"]; ok && additional != false {
+		child, err := b.build(additional)
+		if err != nil {
+			return err
+		}
+		n.additional = child
+	}
+
+	for _, keyword := range []string{"items", "additionalItems"} {
+		if items, ok := m[keyword].(map[string]interface{}); ok {
+			if err := b.addItems(n, items); err != nil {
+				return err
+			}
+		}
+	}
+	for _, keyword := range []string{"prefixItems", "items"} {
+		schemas, _ := m[keyword].([]interface{})
+		for _, items := range schemas {
+			if err := b.addItems(n, items); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		schemas, _ := m[keyword].([]interface{})
+		for _, sub := range schemas {
+			if err := b.add(n, sub); err != nil {
+				return err
+			}
+		}
+	}
+	for _, keyword := range []string{"then", "else"} {
+		if err := b.add(n, m[keyword]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b schemaBuilder) addItems(n *node, schema interface{}) error {
+	child, err := b.build(schema)
+	if err != nil {
+		return err
+	}
+	if n.items == nil {
+		n.items = child
+	} else {
+		n.items = merged(n.items, child)
+	}
+	return nil
+}
+
+// ref - node of the local reference like "#/$defs/name".
+// Recursive references share their node.
+func (b schemaBuilder) ref(ref string) (*node, error) {
+	if n, ok := b.refs[ref]; ok {
+		return n, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("lint: reference %q: only local references are supporting", ref)
+	}
+	pointer, err := url.PathUnescape(ref[1:])
+	if err != nil {
+		return nil, fmt.Errorf("lint: reference %q: %w", ref, err)
+	}
+	target := b.root
+	if pointer != "" {
+		for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+			token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+			m, ok := target.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("lint: reference %q not found", ref)
+			}
+			if target, ok = m[token]; !ok {
+				return nil, fmt.Errorf("lint: reference %q not found", ref)
+			}
+		}
+	}
+	n := &node{}
+	b.refs[ref] = n
+	if err := b.add(n, target); err != nil {
+		return nil, err
+	}
+	if n.fields == nil && n.patterns == nil && n.additional == nil {
+		n.open = true
+	}
+	return n, nil
+}
+
+func hasAny(m map[string]interface{}, keywords []string) bool {
+	for _, keyword := range keywords {
+		if _, ok := m[keyword]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// merge - add known keys of the source node to the node.
+// Nodes are shared by references, so the node's children are
+// copied before merging into them.
+func merge(n, source *node) {
+	mergeInto(n, source, make(map[[2]*node]*node))
+}
+
+// merged - copy of the node with known keys of the source node.
+func merged(n, source *node) *node {
+	return mergedCopy(n, source, make(map[[2]*node]*node))
+}
+
+// mergedCopy - copy of the node merged with the source node,
+// seen holds copies of the already merged pairs for recursive references.
+func mergedCopy(n, source *node, seen map[[2]*node]*node) *node {
+	if copied, ok := seen[[2]*node{n, source}]; ok {
+		return copied
+	}
+	copied := n.copy()
+	seen[[2]*node{n, source}] = copied
+	mergeInto(copied, source, seen)
+	return copied
+}
+
+func mergeInto(n, source *node, seen map[[2]*node]*node) {
+	if source.fields != nil || source.patterns != nil || source.additional != nil {
+		n.open = n.open && source.open
+	}
+	if source.fields != nil && n.fields == nil {
+		n.fields = make(map[string]*node)
+	}
+	for key, child := range source.fields {
+		if existing, ok := n.fields[key]; ok && existing != child {
+			n.fields[key] = mergedCopy(existing, child, seen)
+		} else {
+			n.fields[key] = child
+		}
+	}
+	n.patterns = append(n.patterns, source.patterns...)
+	if source.additional != nil {
+		n.additional = source.additional
+	}
+	if source.items != nil {
+		if n.items == nil {
+			n.items = source.items
+		} else if n.items != source.items {
+			n.items = mergedCopy(n.items, source.items, seen)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package lint
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FromStruct - linter of keys known by the struct (or pointer to struct)
+// type of the value. Keys are names from the tag (like "json" or "yaml")
+// or field names. Fields tagged "-" and unexported fields are skipping,
+// embedded structs without names and ",inline" fields are inlining.
+// Fields of interface{} type allow any keys, maps allow any keys
+// but check their values.
+//
+// Tag `deprecated:"new.key"` marks the field deprecated with the replacement hint.
+func FromStruct(v interface{}, tag string) *Linter {
+	builder := structBuilder{tag: tag, nodes: make(map[reflect.Type]*node)}
+	return &Linter{root: builder.build(reflect.TypeOf(v))}
+}
+
+type structBuilder struct {
+	tag   string
+	nodes map[reflect.Type]*node
+}
+
+func (b structBuilder) build(t reflect.Type) *node {
+	if t == nil {
+		return openNode()
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		if n, ok := b.nodes[t]; ok {
+			return n
+		}
+		// nodes are sharing only by recursive types
+		n := &node{fields: make(map[string]*node)}
+		b.nodes[t] = n
+		b.fields(n, t)
+		delete(b.nodes, t)
+		return n
+	case reflect.Map:
+		return &node{additional: b.build(t.Elem())}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return openNode()
+		}
+		return &node{open: true, items: b.build(t.Elem())}
+	}
+	return openNode()
+}
+
+// fields - add fields of the struct type to the node.
+func (b structBuilder) fields(n *node, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, options := field.Name, ""
+		tag, tagged := field.Tag.Lookup(b.tag)
+		if tagged {
+			if tag == "-" {
+				continue
+			}
+			if j := strings.IndexByte(tag, ','); j >= 0 {
+				tag, options = tag[:j], tag[j:]
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		inline := strings.Contains(options, ",inline") || field.Anonymous && tag == ""
+		if inline {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				b.fields(n, ft)
+				continue
+			}
+			if ft.Kind() == reflect.Map {
+				n.additional = b.build(ft.Elem())
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		child := b.build(field.Type)
+		if replacement, ok := field.Tag.Lookup("deprecated"); ok {
+			copied := *child
+			copied.deprecated, copied.replacement = true, replacement
+			child = &copied
+		}
+		n.fields[name] = child
+	}
+}
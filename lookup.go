@@ -0,0 +1,83 @@
+package object
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// KeyMatch - mode of map keys matching.
+type KeyMatch int
+
+const (
+	// KeyExact - keys are equal.
+	KeyExact KeyMatch = iota
+	// KeyCaseInsensitive - keys are equal ignoring case, like "userName" and "UserName".
+	KeyCaseInsensitive
+	// KeyNormalized - keys are equal ignoring case and separators, so snake_case,
+	// camelCase and kebab-case are equivalent, like "user_name" and "userName".
+	KeyNormalized
+)
+
+// GetOptions - options of the keys lookup.
+type GetOptions struct {
+	// Match - mode of keys matching, KeyExact by default.
+	Match KeyMatch
+}
+
+// normalize - form of the key to compare in the mode.
+func (m KeyMatch) normalize(key string) string {
+	switch m {
+	case KeyCaseInsensitive:
+		return strings.ToLower(key)
+	case KeyNormalized:
+		var b strings.Builder
+		for _, r := range key {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				b.WriteRune(unicode.ToLower(r))
+			}
+		}
+		return b.String()
+	}
+	return key
+}
+
+// GetWith - acts like Get but with options. An exact match is preferred,
+// otherwise if several keys are matching (like "userName" and "user_name"
+// in KeyNormalized mode) it returns ErrorKeyAmbiguous with them in Error.Keys().
+func (o Object) GetWith(key string, options GetOptions) Object {
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	val := deref(*o.val)
+	if options.Match == KeyExact || val.Kind() != reflect.Map {
+		return o.Get(key)
+	}
+
+	normalized := options.Match.normalize(key)
+	var found reflect.Value
+	var matches []string
+	iter := val.MapRange()
+	for iter.Next() {
+		name := keyString(iter.Key())
+		if name == key {
+			v := elem(iter.Value())
+			return Object{&v, nil}
+		}
+		if options.Match.normalize(name) == normalized {
+			found = elem(iter.Value())
+			matches = append(matches, name)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return Object{nil, newFieldError(key, val)}
+	case 1:
+		return Object{&found, nil}
+	}
+	sort.Strings(matches)
+	err := newError(ErrorKeyAmbiguous)
+	err.Key, err.keys = key, matches
+	return Object{nil, err}
+}
@@ -0,0 +1,61 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_GetWith(t *testing.T) {
+	object := NewFromJson([]byte(`{
+		"user_name": "ann",
+		"EMAIL": "a@b.c",
+		"created-at": 1,
+		"id": 1,
+		"ID": 2,
+		"orderId": 5,
+		"order_id": 6
+	}`))
+
+	cases := []struct {
+		name  string
+		key   string
+		match KeyMatch
+		value interface{}
+		err   string
+	}{
+		{"exact", "user_name", KeyExact, "ann", ""},
+		{"exact not found", "userName", KeyExact, nil, ErrorFieldNotFound},
+		{"case", "email", KeyCaseInsensitive, "a@b.c", ""},
+		{"case not normalized", "userName", KeyCaseInsensitive, nil, ErrorFieldNotFound},
+		{"camel to snake", "userName", KeyNormalized, "ann", ""},
+		{"camel to kebab", "createdAt", KeyNormalized, 1.0, ""},
+		{"exact preferred", "ID", KeyCaseInsensitive, 2.0, ""},
+		{"ambiguous case", "Id", KeyCaseInsensitive, nil, ErrorKeyAmbiguous},
+		{"ambiguous normalized", "OrderID", KeyNormalized, nil, ErrorKeyAmbiguous},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			obj := object.GetWith(c.key, GetOptions{Match: c.match})
+			if c.err != "" {
+				if obj.GetError() == nil || obj.GetError().Error() != c.err {
+					t.Fatalf(`expect error %q, got: %v`, c.err, obj.GetError())
+				}
+				return
+			}
+			if obj.GetError() != nil || obj.ToValue() != c.value {
+				t.Fatalf(`unexpected result: %v (%v)`, obj.ToValue(), obj.GetError())
+			}
+		})
+	}
+
+	t.Run("ambiguous keys", func(t *testing.T) {
+		err := object.GetWith("order-id", GetOptions{Match: KeyNormalized}).GetError().(*Error)
+		if len(err.Keys()) != 2 || err.Hint() != `"order-id" matches "orderId" and "order_id"` {
+			t.Fatalf(`unexpected hint: %s`, err.Hint())
+		}
+	})
+	t.Run("slices", func(t *testing.T) {
+		if obj := New([]int{1, 2}).GetWith("1", GetOptions{Match: KeyNormalized}); obj.ToValue() != 2 {
+			t.Fatalf(`unexpected value: %v`, obj.ToValue())
+		}
+	})
+}
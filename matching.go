@@ -0,0 +1,68 @@
+package object
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Match - found object with its path.
+type Match struct {
+	Path  Path
+	Value Object
+}
+
+// GetAll - get children which keys match the glob pattern, where "*" matches
+// any sequence of characters and "?" matches any single character (newlines
+// too), like "user_*" or "2021-??-01". Keys of maps are in ascending order,
+// slice indexes are matching as their strings.
+func (o Object) GetAll(pattern string) []Match {
+	var expr strings.Builder
+	expr.WriteString("(?s)^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+	return o.GetMatching(regexp.MustCompile(expr.String()))
+}
+
+// GetMatching - get children which keys match the regular expression.
+// Keys of maps are in ascending order, slice indexes are matching as their strings.
+func (o Object) GetMatching(re *regexp.Regexp) []Match {
+	entries, _ := o.entries()
+	matches := make([]Match, 0)
+	for _, entry := range entries {
+		if re.MatchString(entry.Key) {
+			matches = append(matches, Match{Path{entry.Key}, entry.Value})
+		}
+	}
+	return matches
+}
+
+// FindKey - find values of the map key at any depth (recursive descent,
+// like `$..id` in JSONPath). Matches are in depth-first order,
+// values of matched keys are searching too.
+func (o Object) FindKey(key string) []Match {
+	matches := make([]Match, 0)
+	o.findKey(key, Path{}, &matches)
+	return matches
+}
+
+func (o Object) findKey(key string, path Path, matches *[]Match) {
+	entries, _ := o.entries()
+	isMap := o.kind() == reflect.Map
+	for _, entry := range entries {
+		child := path.Append(entry.Key)
+		if isMap && entry.Key == key {
+			*matches = append(*matches, Match{child, entry.Value})
+		}
+		entry.Value.findKey(key, child, matches)
+	}
+}
@@ -0,0 +1,81 @@
+package object
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// matchPaths - paths and values of the matches as "path=value" items.
+func matchPaths(matches []Match) string {
+	items := make([]string, 0, len(matches))
+	for _, m := range matches {
+		data, _ := m.Value.ToJson()
+		items = append(items, m.Path.String()+"="+string(data))
+	}
+	return strings.Join(items, " ")
+}
+
+func TestObject_Matching(t *testing.T) {
+	object := NewFromJson([]byte(`{
+		"user_1": {"id": 1, "name": "ann"},
+		"user_2": {"id": 2, "friends": [{"id": 3}, {"name": "bob"}]},
+		"admin": {"id": 4},
+		"2021-01-01": 10,
+		"2021-02-01": 20,
+		"2021-02-15": 30,
+		"a*b": true
+	}`))
+
+	t.Run("glob", func(t *testing.T) {
+		cases := map[string]string{
+			"user_*":     `user_1={"id":1,"name":"ann"} user_2={"friends":[{"id":3},{"name":"bob"}],"id":2}`,
+			"2021-??-01": `2021-01-01=10 2021-02-01=20`,
+			"*-15":       `2021-02-15=30`,
+			"a*b":        `a*b=true`,
+			"a.b":        ``,
+			"nothing*":   ``,
+		}
+		for pattern, expected := range cases {
+			if result := matchPaths(object.GetAll(pattern)); result != expected {
+				t.Fatalf(`unexpected matches of %q: %s`, pattern, result)
+			}
+		}
+	})
+	t.Run("glob newlines", func(t *testing.T) {
+		multiline := New(map[string]int{"a\nb": 1, "a\n": 2, "ab": 3})
+		if matches := multiline.GetAll("a*"); len(matches) != 3 {
+			t.Fatalf(`expect 3 matches, got: %v`, matches)
+		}
+		if matches := multiline.GetAll("a?b"); len(matches) != 1 || matches[0].Value.ToValue() != 1 {
+			t.Fatalf(`expect a\nb match, got: %v`, matches)
+		}
+	})
+	t.Run("regexp", func(t *testing.T) {
+		result := matchPaths(object.GetMatching(regexp.MustCompile(`^\d{4}-02-`)))
+		if result != `2021-02-01=20 2021-02-15=30` {
+			t.Fatalf(`unexpected matches: %s`, result)
+		}
+	})
+	t.Run("slice indexes", func(t *testing.T) {
+		if result := matchPaths(New([]int{5, 6, 7}).GetAll("[12]")); result != `` {
+			t.Fatalf(`unexpected matches: %s`, result)
+		}
+		if result := matchPaths(New([]int{5, 6, 7}).GetMatching(regexp.MustCompile(`^[12]$`))); result != `[1]=6 [2]=7` {
+			t.Fatalf(`unexpected matches: %s`, result)
+		}
+	})
+	t.Run("find key", func(t *testing.T) {
+		if result := matchPaths(object.FindKey("id")); result != `admin.id=4 user_1.id=1 user_2.friends[0].id=3 user_2.id=2` {
+			t.Fatalf(`unexpected matches: %s`, result)
+		}
+		if result := matchPaths(New([]interface{}{[]int{1}}).FindKey("0")); result != `` {
+			t.Fatalf(`unexpected matches: %s`, result)
+		}
+	})
+	t.Run("scalars", func(t *testing.T) {
+		if len(New(1).GetAll("*")) != 0 || len(New(1).FindKey("a")) != 0 || len(Object{}.FindKey("a")) != 0 {
+			t.Fatalf(`unexpected matches`)
+		}
+	})
+}
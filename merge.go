@@ -0,0 +1,99 @@
+package object
+
+// Conflict - path changed differently by both sides of the three-way merge.
+// Not existing objects mean the value is absent (or removed) at the side.
+type Conflict struct {
+	Path   Path
+	Base   Object
+	Ours   Object
+	Theirs Object
+}
+
+// Merge3 - three-way merge of ours and theirs objects changed from the base.
+// Changes of only one side are applying, same changes of both sides are
+// applying once. Maps are merging key by key, other values (slices too)
+// are merging as a whole. Conflicting paths keep our values in the result
+// and are reporting in the order of ascending keys.
+func Merge3(base, ours, theirs Object) (Object, []Conflict) {
+	b, o, t := mergeSide(base), mergeSide(ours), mergeSide(theirs)
+	conflicts := make([]Conflict, 0)
+	result := merge3Plain(Path{}, b, o, t, &conflicts)
+	if !result.exists {
+		return Object{nil, newError(ErrorObjectNotExists)}, conflicts
+	}
+	return New(result.value), conflicts
+}
+
+// mergeValue - plain value which can be absent.
+type mergeValue struct {
+	value  interface{}
+	exists bool
+}
+
+func mergeSide(o Object) mergeValue {
+	if !o.IsExists() {
+		return mergeValue{}
+	}
+	return mergeValue{plain(*o.val), true}
+}
+
+func (v mergeValue) equal(u mergeValue) bool {
+	if !v.exists || !u.exists {
+		return v.exists == u.exists
+	}
+	return equalPlain(v.value, u.value)
+}
+
+func (v mergeValue) object() Object {
+	if !v.exists {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	return New(v.value)
+}
+
+func (v mergeValue) child(key string) mergeValue {
+	if m, ok := v.value.(map[string]interface{}); ok {
+		if value, ok := m[key]; ok {
+			return mergeValue{value, true}
+		}
+	}
+	return mergeValue{}
+}
+
+// merge3Plain - merge plain values at the path collecting conflicts.
+func merge3Plain(path Path, base, ours, theirs mergeValue, conflicts *[]Conflict) mergeValue {
+	switch {
+	case ours.equal(theirs), theirs.equal(base):
+		return ours
+	case ours.equal(base):
+		return theirs
+	}
+
+	om, oIsMap := ours.value.(map[string]interface{})
+	tm, tIsMap := theirs.value.(map[string]interface{})
+	if _, bIsMap := base.value.(map[string]interface{}); oIsMap && tIsMap && (bIsMap || !base.exists) {
+		keys := make(map[string]interface{}, len(om)+len(tm))
+		for key := range om {
+			keys[key] = nil
+		}
+		for key := range tm {
+			keys[key] = nil
+		}
+		result := make(map[string]interface{}, len(keys))
+		for _, key := range sortedKeys(keys) {
+			value := merge3Plain(path.Append(key), base.child(key), ours.child(key), theirs.child(key), conflicts)
+			if value.exists {
+				result[key] = value.value
+			}
+		}
+		return mergeValue{result, true}
+	}
+
+	*conflicts = append(*conflicts, Conflict{
+		Path:   path,
+		Base:   base.object(),
+		Ours:   ours.object(),
+		Theirs: theirs.object(),
+	})
+	return ours
+}
@@ -0,0 +1,65 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestMerge3_Json(t *testing.T) {
+	base := NewFromJson([]byte(`{"name":"app","port":80,"tags":["a"],"db":{"host":"localhost","user":"root"},"old":1}`))
+	ours := NewFromJson([]byte(`{"name":"my-app","port":80,"tags":["a","b"],"db":{"host":"db.local","user":"root"},"old":1}`))
+	theirs := NewFromYaml([]byte("name: app\nport: 8080\ntags: [a, c]\ndb:\n  host: localhost\n  user: admin\n  pass: secret\n"))
+
+	result, conflicts := Merge3(base, ours, theirs)
+
+	t.Run("merged result", func(t *testing.T) {
+		data, _ := result.ToJson()
+		control := `{"db":{"host":"db.local","pass":"secret","user":"admin"},"name":"my-app","port":8080,"tags":["a","b"]}`
+		if string(data) != control {
+			t.Fatalf(`expect %s, got: %s`, control, data)
+		}
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		if len(conflicts) != 1 {
+			t.Fatalf(`expect 1 conflict, got: %v`, conflicts)
+		}
+		c := conflicts[0]
+		if c.Path.String() != "tags" {
+			t.Fatalf(`expect tags path, got: %v`, c.Path)
+		}
+		if data, _ := c.Theirs.ToJson(); string(data) != `["a","c"]` {
+			t.Fatalf(`expect theirs ["a","c"], got: %s`, data)
+		}
+		if data, _ := c.Base.ToJson(); string(data) != `["a"]` {
+			t.Fatalf(`expect base ["a"], got: %s`, data)
+		}
+	})
+
+	t.Run("removed and changed", func(t *testing.T) {
+		_, conflicts := Merge3(
+			NewFromJson([]byte(`{"a":{"b":1}}`)),
+			NewFromJson([]byte(`{}`)),
+			NewFromJson([]byte(`{"a":{"b":2}}`)),
+		)
+		if len(conflicts) != 1 || conflicts[0].Path.String() != "a" {
+			t.Fatalf(`expect conflict at a, got: %v`, conflicts)
+		}
+		if conflicts[0].Ours.IsExists() || !conflicts[0].Theirs.IsExists() {
+			t.Fatalf(`expect removed ours and existing theirs`)
+		}
+	})
+
+	t.Run("both added same", func(t *testing.T) {
+		result, conflicts := Merge3(
+			NewFromJson([]byte(`{}`)),
+			NewFromJson([]byte(`{"a":{"x":1}}`)),
+			NewFromJson([]byte(`{"a":{"x":1,"y":2}}`)),
+		)
+		if len(conflicts) != 0 {
+			t.Fatalf(`expect no conflicts, got: %v`, conflicts)
+		}
+		if data, _ := result.ToJson(); string(data) != `{"a":{"x":1,"y":2}}` {
+			t.Fatalf(`expect merged additions, got: %s`, data)
+		}
+	})
+}
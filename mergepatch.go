@@ -0,0 +1,88 @@
+package object
+
+// MergePatch - apply the JSON Merge Patch (RFC 7386) to a copy of the target.
+// Null values of the patch mean removing of the fields, maps are merging
+// recursively, any other values are replacing the target's ones.
+// Not existing target is considered as null.
+func MergePatch(target, patch Object) Object {
+	if !patch.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	var doc interface{}
+	if target.IsExists() {
+		doc = plain(*target.val)
+	}
+	return New(mergePlain(doc, plain(*patch.val)))
+}
+
+// CreateMergePatch - make a JSON Merge Patch (RFC 7386) which converts
+// the original object to the modified one.
+// Merge patches can't set null values and can't change slices partially,
+// so such values are replacing entirely.
+func CreateMergePatch(original, modified Object) Object {
+	if !modified.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	var doc interface{}
+	if original.IsExists() {
+		doc = plain(*original.val)
+	}
+	return New(diffMergePlain(doc, plain(*modified.val)))
+}
+
+// mergePlain - apply the plain merge patch to the plain target.
+func mergePlain(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+	for key, value := range p {
+		if value == nil {
+			delete(t, key)
+			continue
+		}
+		t[key] = mergePlain(t[key], value)
+	}
+	return t
+}
+
+// diffMergePlain - make the plain merge patch converting a to b.
+func diffMergePlain(a, b interface{}) interface{} {
+	am, ok := a.(map[string]interface{})
+	if !ok {
+		return b
+	}
+	bm, ok := b.(map[string]interface{})
+	if !ok {
+		return b
+	}
+
+	patch := map[string]interface{}{}
+	for key := range am {
+		if _, ok := bm[key]; !ok {
+			patch[key] = nil
+		}
+	}
+	for key, bv := range bm {
+		av, ok := am[key]
+		if !ok {
+			patch[key] = bv
+			continue
+		}
+		if equalPlain(av, bv) {
+			continue
+		}
+		_, aIsMap := av.(map[string]interface{})
+		_, bIsMap := bv.(map[string]interface{})
+		if aIsMap && bIsMap {
+			patch[key] = diffMergePlain(av, bv)
+		} else {
+			patch[key] = bv
+		}
+	}
+	return patch
+}
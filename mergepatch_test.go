@@ -0,0 +1,74 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestMergePatch_Json(t *testing.T) {
+	// Test cases from the RFC 7386 appendix.
+	cases := []struct {
+		target string
+		patch  string
+		result string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, c := range cases {
+		t.Run(c.target+" + "+c.patch, func(t *testing.T) {
+			result := MergePatch(NewFromJson([]byte(c.target)), NewFromJson([]byte(c.patch)))
+			data, _ := result.ToJson()
+			if string(data) != c.result {
+				t.Fatalf(`expect %s, got: %s`, c.result, data)
+			}
+		})
+	}
+
+	t.Run("target untouched", func(t *testing.T) {
+		target := NewFromJson([]byte(`{"a":{"b":1}}`))
+		MergePatch(target, NewFromJson([]byte(`{"a":{"b":null}}`)))
+		if data, _ := target.ToJson(); string(data) != `{"a":{"b":1}}` {
+			t.Fatalf(`expect untouched target, got: %s`, data)
+		}
+	})
+
+	t.Run("not exists target", func(t *testing.T) {
+		result := MergePatch(Object{}, NewFromJson([]byte(`{"a":1}`)))
+		if data, _ := result.ToJson(); string(data) != `{"a":1}` {
+			t.Fatalf(`expect {"a":1}, got: %s`, data)
+		}
+	})
+}
+
+func TestCreateMergePatch_Json(t *testing.T) {
+	original := NewFromJson([]byte(`{"a":{"b":"c","d":1},"e":[1,2],"f":true}`))
+	modified := NewFromYaml([]byte("a:\n  b: x\n  d: 1\ne: [1]\ng: 5\n"))
+
+	t.Run("patch content", func(t *testing.T) {
+		data, _ := CreateMergePatch(original, modified).ToJson()
+		control := `{"a":{"b":"x"},"e":[1],"f":null,"g":5}`
+		if string(data) != control {
+			t.Fatalf(`expect %s, got: %s`, control, data)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		result := MergePatch(original, CreateMergePatch(original, modified))
+		if !equalPlain(plain(*result.val), plain(*modified.val)) {
+			t.Fatalf(`expect equal to modified, got: %v`, plain(*result.val))
+		}
+	})
+}
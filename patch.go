@@ -0,0 +1,402 @@
+package object
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// JSON Patch (RFC 6902) operations.
+const (
+	PatchAdd     = "add"
+	PatchRemove  = "remove"
+	PatchReplace = "replace"
+	PatchMove    = "move"
+	PatchCopy    = "copy"
+	PatchTest    = "test"
+)
+
+// PatchOperation - single operation of the JSON Patch (RFC 6902).
+// Path and From are JSON Pointers (RFC 6901).
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+
+	// members missing in the deserialized operation
+	noPath, noFrom, noValue bool
+}
+
+// MarshalJSON - serialize the operation with the "value" member kept
+// for add, replace and test operations even if it's null, and the "from"
+// member kept for move and copy operations even if it's the root.
+// Members missing in the deserialized operation are kept missing.
+func (p PatchOperation) MarshalJSON() ([]byte, error) {
+	type operation struct {
+		Op    string       `json:"op"`
+		Path  *string      `json:"path,omitempty"`
+		From  *string      `json:"from,omitempty"`
+		Value *interface{} `json:"value,omitempty"`
+	}
+	op := operation{Op: p.Op}
+	if !p.noPath {
+		op.Path = &p.Path
+	}
+	switch p.Op {
+	case PatchAdd, PatchReplace, PatchTest:
+		if !p.noValue {
+			op.Value = &p.Value
+		}
+	case PatchMove, PatchCopy:
+		if !p.noFrom {
+			op.From = &p.From
+		}
+	}
+	return json.Marshal(op)
+}
+
+// UnmarshalJSON - deserialize the operation remembering whether
+// the "path", "from" and "value" members exist, operations without
+// members required by RFC 6902 are failing on apply.
+func (p *PatchOperation) UnmarshalJSON(data []byte) error {
+	type operation PatchOperation
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	var op operation
+	if err := json.Unmarshal(data, &op); err != nil {
+		return err
+	}
+	*p = PatchOperation(op)
+	_, ok := members["path"]
+	p.noPath = !ok
+	_, ok = members["from"]
+	p.noFrom = !ok
+	_, ok = members["value"]
+	p.noValue = !ok
+	return nil
+}
+
+// Patch - JSON Patch (RFC 6902) document.
+// It can be serialized and deserialized with encoding/json as is.
+type Patch []PatchOperation
+
+// CreatePatch - make a patch which converts the from-object to the to-object.
+// Maps are compared key by key in ascending order, slices index by index
+// (with removing or appending of the tail), other values are replacing.
+func CreatePatch(from, to Object) Patch {
+	var a, b interface{}
+	if from.IsExists() {
+		a = plain(*from.val)
+	}
+	if to.IsExists() {
+		b = plain(*to.val)
+	}
+	return diffPlain(Patch{}, "", a, b)
+}
+
+// ApplyPatch - apply the patch operations to a copy of the object.
+// Operations are applying atomically: if any of them fails, the result
+// is an object with the error, and the source object stays untouched.
+func (o Object) ApplyPatch(patch Patch) Object {
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+
+	doc := plain(*o.val)
+	for _, op := range patch {
+		var err error
+		if doc, err = op.apply(doc); err != nil {
+			return Object{nil, err}
+		}
+	}
+	return New(doc)
+}
+
+// apply - apply the operation to the plain document.
+func (p PatchOperation) apply(doc interface{}) (interface{}, error) {
+	if !p.hasMembers() {
+		return nil, newError(ErrorPatchOperation)
+	}
+	path, err := parsePointer(p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.Op {
+	case PatchAdd:
+		return pointerAdd(doc, path, copyPlain(p.Value))
+	case PatchRemove:
+		return pointerRemove(doc, path)
+	case PatchReplace:
+		return pointerReplace(doc, path, copyPlain(p.Value))
+	case PatchMove, PatchCopy:
+		from, err := parsePointer(p.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := pointerGet(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if p.Op == PatchCopy {
+			return pointerAdd(doc, path, copyPlain(value))
+		}
+		if p.From == p.Path {
+			return doc, nil
+		}
+		if strings.HasPrefix(p.Path, p.From+"/") {
+			return nil, newError(ErrorPatchOperation)
+		}
+		if doc, err = pointerRemove(doc, from); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, path, value)
+	case PatchTest:
+		value, err := pointerGet(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equalPlain(value, copyPlain(p.Value)) {
+			return nil, newError(ErrorPatchTest)
+		}
+		return doc, nil
+	}
+
+	return nil, newError(ErrorPatchOperation)
+}
+
+// hasMembers - check that members required by the operation
+// weren't missing in the deserialized operation.
+func (p PatchOperation) hasMembers() bool {
+	switch p.Op {
+	case PatchAdd, PatchReplace, PatchTest:
+		return !p.noPath && !p.noValue
+	case PatchMove, PatchCopy:
+		return !p.noPath && !p.noFrom
+	}
+	return !p.noPath
+}
+
+// diffPlain - append operations converting a to b into the patch.
+func diffPlain(patch Patch, pointer string, a, b interface{}) Patch {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, key := range sortedKeys(av) {
+			if _, ok := bv[key]; !ok {
+				patch = append(patch, PatchOperation{Op: PatchRemove, Path: pointer + "/" + escapePointer(key)})
+			}
+		}
+		for _, key := range sortedKeys(bv) {
+			path := pointer + "/" + escapePointer(key)
+			if value, ok := av[key]; ok {
+				patch = diffPlain(patch, path, value, bv[key])
+			} else {
+				patch = append(patch, PatchOperation{Op: PatchAdd, Path: path, Value: bv[key]})
+			}
+		}
+		return patch
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+		common := len(av)
+		if len(bv) < common {
+			common = len(bv)
+		}
+		for i := 0; i < common; i++ {
+			patch = diffPlain(patch, pointer+"/"+strconv.Itoa(i), av[i], bv[i])
+		}
+		for i := len(av) - 1; i >= len(bv); i-- {
+			patch = append(patch, PatchOperation{Op: PatchRemove, Path: pointer + "/" + strconv.Itoa(i)})
+		}
+		for i := len(av); i < len(bv); i++ {
+			patch = append(patch, PatchOperation{Op: PatchAdd, Path: pointer + "/" + strconv.Itoa(i), Value: bv[i]})
+		}
+		return patch
+	}
+
+	if !equalPlain(a, b) {
+		patch = append(patch, PatchOperation{Op: PatchReplace, Path: pointer, Value: b})
+	}
+	return patch
+}
+
+// escapePointer - escape the key to be a JSON Pointer reference token.
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// parsePointer - split the JSON Pointer into unescaped reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if pointer[0] != '/' {
+		return nil, newError(ErrorPointerParse)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, newError(ErrorPointerParse)
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// parsePointerIndex - parse the reference token as an array index.
+// Leading zeros and signs aren't allowed.
+func parsePointerIndex(token string) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, newError(ErrorIndexParse)
+	}
+	for _, c := range token {
+		if c < '0' || c > '9' {
+			return 0, newError(ErrorIndexParse)
+		}
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, newError(ErrorIndexParse)
+	}
+	return index, nil
+}
+
+// pointerChild - get the child of the plain container by the reference token.
+func pointerChild(container interface{}, token string) (interface{}, error) {
+	switch c := container.(type) {
+	case map[string]interface{}:
+		value, ok := c[token]
+		if !ok {
+			return nil, newError(ErrorFieldNotFound)
+		}
+		return value, nil
+	case []interface{}:
+		index, err := parsePointerIndex(token)
+		if err != nil {
+			return nil, err
+		}
+		if index >= len(c) {
+			return nil, newError(ErrorIndexRange)
+		}
+		return c[index], nil
+	}
+	return nil, newError(ErrorTypeNotSupport)
+}
+
+// pointerGet - get the value of the plain document by the reference tokens.
+func pointerGet(doc interface{}, path []string) (interface{}, error) {
+	value := doc
+	for _, token := range path {
+		var err error
+		if value, err = pointerChild(value, token); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// pointerUpdate - call fn with the parent container of the path's target
+// and put the container returned by fn back into the document.
+func pointerUpdate(doc interface{}, path []string, fn func(container interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+	child, err := pointerChild(doc, path[0])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = pointerUpdate(child, path[1:], fn); err != nil {
+		return nil, err
+	}
+	switch c := doc.(type) {
+	case map[string]interface{}:
+		c[path[0]] = child
+	case []interface{}:
+		index, _ := parsePointerIndex(path[0])
+		c[index] = child
+	}
+	return doc, nil
+}
+
+// pointerAdd - insert the value into the plain document.
+// For slices the value is inserting before the index, "-" means the end.
+func pointerAdd(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return pointerUpdate(doc, path, func(container interface{}, token string) (interface{}, error) {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			c[token] = value
+			return c, nil
+		case []interface{}:
+			if token == "-" {
+				return append(c, value), nil
+			}
+			index, err := parsePointerIndex(token)
+			if err != nil {
+				return nil, err
+			}
+			if index > len(c) {
+				return nil, newError(ErrorIndexRange)
+			}
+			c = append(c, nil)
+			copy(c[index+1:], c[index:])
+			c[index] = value
+			return c, nil
+		}
+		return nil, newError(ErrorTypeNotSupport)
+	})
+}
+
+// pointerRemove - remove the value from the plain document.
+func pointerRemove(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	return pointerUpdate(doc, path, func(container interface{}, token string) (interface{}, error) {
+		if _, err := pointerChild(container, token); err != nil {
+			return nil, err
+		}
+		switch c := container.(type) {
+		case map[string]interface{}:
+			delete(c, token)
+			return c, nil
+		case []interface{}:
+			index, _ := parsePointerIndex(token)
+			return append(c[:index], c[index+1:]...), nil
+		}
+		return nil, newError(ErrorTypeNotSupport)
+	})
+}
+
+// pointerReplace - replace the existing value of the plain document.
+func pointerReplace(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return pointerUpdate(doc, path, func(container interface{}, token string) (interface{}, error) {
+		if _, err := pointerChild(container, token); err != nil {
+			return nil, err
+		}
+		switch c := container.(type) {
+		case map[string]interface{}:
+			c[token] = value
+		case []interface{}:
+			index, _ := parsePointerIndex(token)
+			c[index] = value
+		}
+		return container, nil
+	})
+}
@@ -0,0 +1,172 @@
+package object
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCreatePatch_Json(t *testing.T) {
+	from := NewFromJson([]byte(`{"a":{"b":"c","d":-500.5},"e":[3,2,1],"f":true}`))
+	to := NewFromJson([]byte(`{"a":{"b":"x","g":null},"e":[3,2],"h":"new"}`))
+
+	t.Run("patch content", func(t *testing.T) {
+		patch, _ := json.Marshal(CreatePatch(from, to))
+		control := `[{"op":"remove","path":"/f"},` +
+			`{"op":"remove","path":"/a/d"},` +
+			`{"op":"replace","path":"/a/b","value":"x"},` +
+			`{"op":"add","path":"/a/g","value":null},` +
+			`{"op":"remove","path":"/e/2"},` +
+			`{"op":"add","path":"/h","value":"new"}]`
+		if string(patch) != control {
+			t.Fatalf(`expect %s, got: %s`, control, patch)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		result := from.ApplyPatch(CreatePatch(from, to))
+		if result.GetError() != nil {
+			t.Fatalf(`unexpected error: %v`, result.GetError())
+		}
+		if !equalPlain(plain(*result.val), plain(*to.val)) {
+			t.Fatalf(`expect equal to target, got: %v`, plain(*result.val))
+		}
+	})
+
+	t.Run("equal documents from different formats", func(t *testing.T) {
+		yaml := NewFromYaml([]byte("a:\n  b: c\n  d: -500.5\ne: [3, 2, 1]\nf: true\n"))
+		if patch := CreatePatch(from, yaml); len(patch) != 0 {
+			t.Fatalf(`expect empty patch, got: %v`, patch)
+		}
+	})
+}
+
+func TestObject_ApplyPatch_Json(t *testing.T) {
+	source := []byte(`{"a":{"b":"c"},"e":[3,2,1]}`)
+
+	apply := func(t *testing.T, patch string) Object {
+		var p Patch
+		if err := json.Unmarshal([]byte(patch), &p); err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		return NewFromJson(source).ApplyPatch(p)
+	}
+
+	cases := []struct {
+		name  string
+		patch string
+		json  string
+	}{
+		{"add field", `[{"op":"add","path":"/a/x","value":1}]`, `{"a":{"b":"c","x":1},"e":[3,2,1]}`},
+		{"add into slice", `[{"op":"add","path":"/e/1","value":9}]`, `{"a":{"b":"c"},"e":[3,9,2,1]}`},
+		{"append to slice", `[{"op":"add","path":"/e/-","value":0}]`, `{"a":{"b":"c"},"e":[3,2,1,0]}`},
+		{"remove from slice", `[{"op":"remove","path":"/e/0"}]`, `{"a":{"b":"c"},"e":[2,1]}`},
+		{"replace", `[{"op":"replace","path":"/a/b","value":[1]}]`, `{"a":{"b":[1]},"e":[3,2,1]}`},
+		{"move", `[{"op":"move","from":"/a/b","path":"/z"}]`, `{"a":{},"e":[3,2,1],"z":"c"}`},
+		{"copy", `[{"op":"copy","from":"/e","path":"/a/e"}]`, `{"a":{"b":"c","e":[3,2,1]},"e":[3,2,1]}`},
+		{"test passed", `[{"op":"test","path":"/e/0","value":3}]`, `{"a":{"b":"c"},"e":[3,2,1]}`},
+		{"add null", `[{"op":"add","path":"/a/x","value":null}]`, `{"a":{"b":"c","x":null},"e":[3,2,1]}`},
+		{"replace root", `[{"op":"replace","path":"","value":"root"}]`, `"root"`},
+		{"remove with from", `[{"op":"remove","path":"/e","from":"/a"}]`, `{"a":{"b":"c"}}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := apply(t, c.patch)
+			if result.GetError() != nil {
+				t.Fatalf(`unexpected error: %v`, result.GetError())
+			}
+			data, _ := result.ToJson()
+			if string(data) != c.json {
+				t.Fatalf(`expect %s, got: %s`, c.json, data)
+			}
+		})
+	}
+
+	failures := []struct {
+		name  string
+		patch string
+		err   string
+	}{
+		{"test failed", `[{"op":"test","path":"/a/b","value":"d"}]`, ErrorPatchTest},
+		{"remove not exists", `[{"op":"remove","path":"/x"}]`, ErrorFieldNotFound},
+		{"index out of range", `[{"op":"add","path":"/e/4","value":1}]`, ErrorIndexRange},
+		{"leading zero index", `[{"op":"replace","path":"/e/01","value":1}]`, ErrorIndexParse},
+		{"move into child", `[{"op":"move","from":"/a","path":"/a/b/c"}]`, ErrorPatchOperation},
+		{"unknown operation", `[{"op":"merge","path":"/a"}]`, ErrorPatchOperation},
+		{"add without value", `[{"op":"add","path":"/a/x"}]`, ErrorPatchOperation},
+		{"replace without value", `[{"op":"replace","path":"/a/b"}]`, ErrorPatchOperation},
+		{"test without value", `[{"op":"test","path":"/a/b"}]`, ErrorPatchOperation},
+		{"add without path", `[{"op":"add","value":1}]`, ErrorPatchOperation},
+		{"remove without path", `[{"op":"remove"}]`, ErrorPatchOperation},
+		{"copy without from", `[{"op":"copy","path":"/a/x"}]`, ErrorPatchOperation},
+		{"move without from", `[{"op":"move","path":"/a/x"}]`, ErrorPatchOperation},
+		{"bad pointer", `[{"op":"remove","path":"a/b"}]`, ErrorPointerParse},
+		{"bad escape", `[{"op":"remove","path":"/a~2"}]`, ErrorPointerParse},
+	}
+	for _, c := range failures {
+		t.Run(c.name, func(t *testing.T) {
+			result := apply(t, c.patch)
+			if result.GetError() == nil || result.GetError().Error() != c.err {
+				t.Fatalf(`expect error %q, got: %v`, c.err, result.GetError())
+			}
+		})
+	}
+
+	t.Run("atomic on failure", func(t *testing.T) {
+		object := NewFromJson(source)
+		result := object.ApplyPatch(Patch{
+			{Op: PatchRemove, Path: "/a/b"},
+			{Op: PatchTest, Path: "/a/b", Value: "c"},
+		})
+		if result.IsExists() {
+			t.Fatalf(`expect not exists result`)
+		}
+		data, _ := object.ToJson()
+		if string(data) != string(source) {
+			t.Fatalf(`expect untouched source %s, got: %s`, source, data)
+		}
+	})
+
+	t.Run("test typed value", func(t *testing.T) {
+		result := NewFromJson(source).ApplyPatch(Patch{{Op: PatchTest, Path: "/e", Value: []int{3, 2, 1}}})
+		if result.GetError() != nil {
+			t.Fatalf(`unexpected error: %v`, result.GetError())
+		}
+	})
+
+	t.Run("serialize root from", func(t *testing.T) {
+		data, _ := json.Marshal(Patch{{Op: PatchCopy, From: "", Path: "/a/root"}, {Op: PatchRemove, Path: "/e"}})
+		if string(data) != `[{"op":"copy","path":"/a/root","from":""},{"op":"remove","path":"/e"}]` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+		var patch Patch
+		if err := json.Unmarshal(data, &patch); err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		if data, _ := json.Marshal(patch); string(data) != `[{"op":"copy","path":"/a/root","from":""},{"op":"remove","path":"/e"}]` {
+			t.Fatalf(`expect same serialization, got: %s`, data)
+		}
+		if result := NewFromJson(source).ApplyPatch(patch); result.GetPath("a.root.a.b").ToValue() != "c" {
+			t.Fatalf(`unexpected result: %v`, result.ToValue())
+		}
+	})
+
+	t.Run("serialize missing members", func(t *testing.T) {
+		var patch Patch
+		if err := json.Unmarshal([]byte(`[{"op":"add"},{"op":"move","path":"/a"}]`), &patch); err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		if data, _ := json.Marshal(patch); string(data) != `[{"op":"add"},{"op":"move","path":"/a"}]` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	t.Run("escaped keys", func(t *testing.T) {
+		result := New(map[string]interface{}{"a/b": 1, "c~d": 2}).ApplyPatch(Patch{
+			{Op: PatchRemove, Path: "/a~1b"},
+			{Op: PatchRemove, Path: "/c~0d"},
+		})
+		if data, _ := result.ToJson(); string(data) != `{}` {
+			t.Fatalf(`expect {}, got: %s`, data)
+		}
+	})
+}
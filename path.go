@@ -0,0 +1,138 @@
+package object
+
+import (
+	"strings"
+)
+
+// Path - chain of keys to reach a sub-object.
+// Every key acts the same way as the Get argument,
+// so slice indexes are keys too.
+type Path []string
+
+// ParsePath - parse JavaScript-like path like `a.b[1]["c.d"]`.
+// Keys with special symbols have to be quoted inside brackets.
+// Empty string is the path to the object itself.
+func ParsePath(path string) (Path, error) {
+	keys := Path{}
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '[':
+			if i+1 < len(path) && (path[i+1] == '"' || path[i+1] == '\'') {
+				key, end, err := unquoteKey(path, i+1)
+				if err != nil {
+					return nil, err
+				}
+				if end >= len(path) || path[end] != ']' {
+					return nil, newError(ErrorPathParse)
+				}
+				keys = append(keys, key)
+				i = end + 1
+				continue
+			}
+			end := strings.IndexByte(path[i:], ']')
+			if end <= 1 {
+				return nil, newError(ErrorPathParse)
+			}
+			keys = append(keys, strings.TrimSpace(path[i+1:i+end]))
+			i += end + 1
+		case '.':
+			if i == 0 || i+1 == len(path) || path[i+1] == '.' || path[i+1] == '[' {
+				return nil, newError(ErrorPathParse)
+			}
+			i++
+		case ']', '"', '\'':
+			return nil, newError(ErrorPathParse)
+		default:
+			end := strings.IndexAny(path[i:], ".[]\"'")
+			if end < 0 {
+				end = len(path) - i
+			} else if c := path[i+end]; c != '.' && c != '[' {
+				return nil, newError(ErrorPathParse)
+			}
+			keys = append(keys, path[i:i+end])
+			i += end
+		}
+	}
+	return keys, nil
+}
+
+// unquoteKey - read single or double quoted key starting at the index.
+// Returns the key and the index after the closing quote.
+func unquoteKey(path string, start int) (string, int, error) {
+	quote := path[start]
+	var b strings.Builder
+	for i := start + 1; i < len(path); i++ {
+		switch path[i] {
+		case quote:
+			return b.String(), i + 1, nil
+		case '\\':
+			i++
+			if i == len(path) {
+				return "", 0, newError(ErrorPathParse)
+			}
+		}
+		b.WriteByte(path[i])
+	}
+	return "", 0, newError(ErrorPathParse)
+}
+
+// String - JavaScript-like representation of the path.
+// It can be parsed back with ParsePath.
+func (p Path) String() string {
+	var b strings.Builder
+	for _, key := range p {
+		switch {
+		case isIndexKey(key):
+			b.WriteString("[" + key + "]")
+		case key == "" || strings.ContainsAny(key, ".[]\"' \t\n"):
+			b.WriteString(`["` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(key) + `"]`)
+		default:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(key)
+		}
+	}
+	return b.String()
+}
+
+// Append - make a new path with the keys added to the end.
+// The source path isn't modified.
+func (p Path) Append(keys ...string) Path {
+	path := make(Path, 0, len(p)+len(keys))
+	path = append(path, p...)
+	return append(path, keys...)
+}
+
+// isIndexKey - check that the key is a non-negative integer.
+func isIndexKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, c := range key {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// GetPath - get sub-object by the JavaScript-like path like `a.b[1]["c.d"]`.
+// See ParsePath for the syntax.
+func (o Object) GetPath(path string) Object {
+	keys, err := ParsePath(path)
+	if err != nil {
+		return Object{nil, err}
+	}
+	return o.GetByPath(keys)
+}
+
+// GetByPath - get sub-object by the chain of keys.
+// Acts like sequential Get calls.
+func (o Object) GetByPath(path Path) Object {
+	obj := o
+	for _, key := range path {
+		obj = obj.Get(key)
+	}
+	return obj
+}
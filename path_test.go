@@ -0,0 +1,101 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	cases := []struct {
+		path string
+		keys Path
+	}{
+		{``, Path{}},
+		{`a`, Path{"a"}},
+		{`a.b.c`, Path{"a", "b", "c"}},
+		{`e[1]`, Path{"e", "1"}},
+		{`[0][1].a`, Path{"0", "1", "a"}},
+		{`a["b.c"].d`, Path{"a", "b.c", "d"}},
+		{`a['it\'s']`, Path{"a", "it's"}},
+		{`a["q\"uote"]`, Path{"a", `q"uote`}},
+		{`not-exists.d`, Path{"not-exists", "d"}},
+	}
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			keys, err := ParsePath(c.path)
+			if err != nil {
+				t.Fatalf(`unexpected error: %v`, err)
+			}
+			if !reflect.DeepEqual(keys, c.keys) {
+				t.Fatalf(`expect %q, got: %q`, c.keys, keys)
+			}
+		})
+	}
+
+	for _, path := range []string{`.a`, `a.`, `a..b`, `a[`, `a[]`, `a["b]`, `a]`, `a"b`, `a.[0]`} {
+		t.Run("invalid "+path, func(t *testing.T) {
+			if _, err := ParsePath(path); err == nil || err.Error() != ErrorPathParse {
+				t.Fatalf(`expect ErrorPathParse, got: %v`, err)
+			}
+		})
+	}
+}
+
+func TestPath_String(t *testing.T) {
+	cases := []struct {
+		keys Path
+		path string
+	}{
+		{Path{}, ``},
+		{Path{"a", "b"}, `a.b`},
+		{Path{"e", "1"}, `e[1]`},
+		{Path{"0", "a"}, `[0].a`},
+		{Path{"a", "b.c", ""}, `a["b.c"][""]`},
+		{Path{"a", `q"uote`}, `a["q\"uote"]`},
+	}
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			if c.keys.String() != c.path {
+				t.Fatalf(`expect %s, got: %s`, c.path, c.keys.String())
+			}
+			keys, _ := ParsePath(c.path)
+			if !reflect.DeepEqual(keys, c.keys) {
+				t.Fatalf(`expect round trip %q, got: %q`, c.keys, keys)
+			}
+		})
+	}
+}
+
+func TestObject_GetPath_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{"a":{"b":"c","d.e":-500.5},"e":[3,2,1]}`))
+
+	t.Run("nested value", func(t *testing.T) {
+		if obj := object.GetPath(`a.b`); obj.ToValue() != "c" {
+			t.Fatalf(`expect "c", got: %v`, obj.ToValue())
+		}
+	})
+
+	t.Run("quoted key", func(t *testing.T) {
+		if obj := object.GetPath(`a["d.e"]`); obj.ToValue() != -500.5 {
+			t.Fatalf(`expect -500.5, got: %v`, obj.ToValue())
+		}
+	})
+
+	t.Run("slice index", func(t *testing.T) {
+		if obj := object.GetPath(`e[1]`); obj.ToValue() != 2.0 {
+			t.Fatalf(`expect 2, got: %v`, obj.ToValue())
+		}
+	})
+
+	t.Run("not exists", func(t *testing.T) {
+		if obj := object.GetPath(`a.x.y`); obj.IsExists() {
+			t.Fatalf(`expect not exists`)
+		}
+	})
+
+	t.Run("parse error", func(t *testing.T) {
+		if obj := object.GetPath(`a..b`); obj.GetError().Error() != ErrorPathParse {
+			t.Fatalf(`expect ErrorPathParse, got: %v`, obj.GetError())
+		}
+	})
+}
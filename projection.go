@@ -0,0 +1,124 @@
+package object
+
+import "strconv"
+
+// Pick - make a new object only with values at the paths (ParsePath syntax).
+// Key "*" matches any key, like `items[*].id`. Picked slice elements keep
+// their order but are re-indexing. Not existing paths are skipping.
+func (o Object) Pick(paths ...string) Object {
+	patterns, err := parsePatterns(paths)
+	if err != nil {
+		return Object{nil, err}
+	}
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	result, _ := pickPlain(plain(*o.val), patterns)
+	return New(result)
+}
+
+// Omit - make a new object without values at the paths (ParsePath syntax).
+// Key "*" matches any key, like `items[*].secret`. Slice elements
+// are re-indexing after omitting.
+func (o Object) Omit(paths ...string) Object {
+	patterns, err := parsePatterns(paths)
+	if err != nil {
+		return Object{nil, err}
+	}
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	return New(omitPlain(plain(*o.val), patterns))
+}
+
+func parsePatterns(paths []string) ([]Path, error) {
+	patterns := make([]Path, 0, len(paths))
+	for _, p := range paths {
+		path, err := ParsePath(p)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, path)
+	}
+	return patterns, nil
+}
+
+// childPatterns - rest of the patterns which first key matches the key.
+// Returns true if any of the patterns ends at the key.
+func childPatterns(patterns []Path, key string) ([]Path, bool) {
+	rest := make([]Path, 0, len(patterns))
+	ends := false
+	for _, pattern := range patterns {
+		if len(pattern) == 0 || (pattern[0] != "*" && pattern[0] != key) {
+			continue
+		}
+		if len(pattern) == 1 {
+			ends = true
+		}
+		rest = append(rest, pattern[1:])
+	}
+	return rest, ends
+}
+
+// pickPlain - pick the patterns of the plain value,
+// returns false if nothing matches.
+func pickPlain(v interface{}, patterns []Path) (interface{}, bool) {
+	for _, pattern := range patterns {
+		if len(pattern) == 0 {
+			return v, true
+		}
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{})
+		for key, value := range v {
+			if rest, _ := childPatterns(patterns, key); len(rest) > 0 {
+				if picked, ok := pickPlain(value, rest); ok {
+					result[key] = picked
+				}
+			}
+		}
+		return result, len(result) > 0
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for i, value := range v {
+			if rest, _ := childPatterns(patterns, strconv.Itoa(i)); len(rest) > 0 {
+				if picked, ok := pickPlain(value, rest); ok {
+					result = append(result, picked)
+				}
+			}
+		}
+		return result, len(result) > 0
+	}
+	return nil, false
+}
+
+// omitPlain - remove the patterns from the plain value.
+func omitPlain(v interface{}, patterns []Path) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			rest, ends := childPatterns(patterns, key)
+			if ends {
+				delete(v, key)
+			} else if len(rest) > 0 {
+				v[key] = omitPlain(value, rest)
+			}
+		}
+		return v
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for i, value := range v {
+			rest, ends := childPatterns(patterns, strconv.Itoa(i))
+			if ends {
+				continue
+			}
+			if len(rest) > 0 {
+				value = omitPlain(value, rest)
+			}
+			result = append(result, value)
+		}
+		return result
+	}
+	return v
+}
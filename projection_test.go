@@ -0,0 +1,72 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_Pick_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{
+		"user":{"id":1,"name":"ann","password":"secret"},
+		"items":[{"id":10,"price":5,"meta":{"a":1}},{"id":11,"price":7}],
+		"total":12
+	}`))
+
+	cases := []struct {
+		name  string
+		paths []string
+		json  string
+	}{
+		{"fields", []string{"user.id", "total"}, `{"total":12,"user":{"id":1}}`},
+		{"whole sub-object", []string{"user"}, `{"user":{"id":1,"name":"ann","password":"secret"}}`},
+		{"wildcard in slice", []string{"items[*].id"}, `{"items":[{"id":10},{"id":11}]}`},
+		{"slice index", []string{"items[1].price"}, `{"items":[{"price":7}]}`},
+		{"partially missing", []string{"items[*].meta.a"}, `{"items":[{"meta":{"a":1}}]}`},
+		{"missing", []string{"x.y"}, `{}`},
+		{"wildcard map", []string{"*.id"}, `{"user":{"id":1}}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, _ := object.Pick(c.paths...).ToCanonicalJson()
+			if string(data) != c.json {
+				t.Fatalf(`expect %s, got: %s`, c.json, data)
+			}
+		})
+	}
+
+	t.Run("bad path", func(t *testing.T) {
+		if obj := object.Pick("a..b"); obj.GetError().Error() != ErrorPathParse {
+			t.Fatalf(`expect ErrorPathParse, got: %v`, obj.GetError())
+		}
+	})
+}
+
+func TestObject_Omit_Json(t *testing.T) {
+	source := []byte(`{"user":{"id":1,"password":"secret"},"items":[{"id":10,"token":"x"},{"id":11}],"total":12}`)
+	object := NewFromJson(source)
+
+	cases := []struct {
+		name  string
+		paths []string
+		json  string
+	}{
+		{"fields", []string{"user.password", "total"}, `{"items":[{"id":10,"token":"x"},{"id":11}],"user":{"id":1}}`},
+		{"wildcard in slice", []string{"items[*].token"}, `{"items":[{"id":10},{"id":11}],"total":12,"user":{"id":1,"password":"secret"}}`},
+		{"slice element", []string{"items[0]"}, `{"items":[{"id":11}],"total":12,"user":{"id":1,"password":"secret"}}`},
+		{"missing", []string{"x.y"}, `{"items":[{"id":10,"token":"x"},{"id":11}],"total":12,"user":{"id":1,"password":"secret"}}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, _ := object.Omit(c.paths...).ToCanonicalJson()
+			if string(data) != c.json {
+				t.Fatalf(`expect %s, got: %s`, c.json, data)
+			}
+		})
+	}
+
+	t.Run("source untouched", func(t *testing.T) {
+		object.Omit("user", "items")
+		if data, _ := object.ToJson(); !Equal(NewFromJson(data), NewFromJson(source)) {
+			t.Fatalf(`expect untouched source, got: %s`, data)
+		}
+	})
+}
@@ -0,0 +1,334 @@
+// Package rules validates objects by fluent rules of their paths:
+//
+//	errs := rules.Rules{
+//		"a.b":  rules.Required().String().MaxLen(20),
+//		"e[*]": rules.Int().Min(0),
+//	}.Validate(object.NewFromData(data))
+//	for _, e := range errs {
+//		fmt.Println(e) // a.b: length 25 is greater than 20
+//	}
+//
+// Unlike JSON Schema (see the schema package) rules are plain Go code,
+// so custom checks are just functions.
+package rules
+
+import (
+	"fmt"
+	"github.com/the-go-tool/object"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Rules - validation rules by paths (object.ParsePath syntax).
+// Key "*" matches any key, like `e[*]`.
+type Rules map[string]Rule
+
+// Rule - chain of checks of a value. Values are coercing like
+// in object's IsInt, IsFloat and IsString, so "5" is an integer.
+// Not existing and null values are skipping unless it's Required.
+type Rule struct {
+	required bool
+	checks   []ruleCheck
+}
+
+type ruleCheck struct {
+	name  string
+	check func(o object.Object) string // returns the violation message
+}
+
+// ValidationError - violation of the rule.
+type ValidationError struct {
+	// Path - path of the invalid value.
+	Path object.Path
+	// Rule - name of the failed check like "max_len".
+	Rule string
+	// Message - human-readable description of the violation.
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Validate - check the object by all the rules and return all violations
+// ordered by paths. Checks of a value stop on its first violation.
+// Empty result means the object is valid.
+func (r Rules) Validate(o object.Object) []ValidationError {
+	patterns := make([]string, 0, len(r))
+	for pattern := range r {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	errs := make([]ValidationError, 0)
+	for _, pattern := range patterns {
+		path, err := object.ParsePath(pattern)
+		if err != nil {
+			errs = append(errs, ValidationError{Path: object.Path{pattern}, Rule: "path", Message: err.Error()})
+			continue
+		}
+		rule := r[pattern]
+		expandPath(o, path, object.Path{}, func(path object.Path, value object.Object) {
+			if name, message := rule.check(value); message != "" {
+				errs = append(errs, ValidationError{Path: path, Rule: name, Message: message})
+			}
+		})
+	}
+	return errs
+}
+
+// expandPath - call fn for every path matching the pattern.
+// Not existing values at exact keys are passing too.
+func expandPath(o object.Object, pattern object.Path, prefix object.Path, fn func(object.Path, object.Object)) {
+	if len(pattern) == 0 {
+		fn(prefix, o)
+		return
+	}
+	if pattern[0] != "*" {
+		expandPath(o.Get(pattern[0]), pattern[1:], prefix.Append(pattern[0]), fn)
+		return
+	}
+	o.ForEach(func(entry object.Entry) {
+		expandPath(entry.Value, pattern[1:], prefix.Append(entry.Key), fn)
+	})
+}
+
+// check - run the checks, returns the failed check name and the message.
+func (r Rule) check(o object.Object) (string, string) {
+	if !o.IsExists() || o.IsNil() {
+		if r.required {
+			return "required", "value is required"
+		}
+		return "", ""
+	}
+	for _, c := range r.checks {
+		if message := c.check(o); message != "" {
+			return c.name, message
+		}
+	}
+	return "", ""
+}
+
+// with - copy of the rule with one more check.
+func (r Rule) with(name string, check func(o object.Object) string) Rule {
+	checks := make([]ruleCheck, len(r.checks), len(r.checks)+1)
+	copy(checks, r.checks)
+	r.checks = append(checks, ruleCheck{name, check})
+	return r
+}
+
+// Required - value must exist and not be null.
+func Required() Rule {
+	return Rule{}.Required()
+}
+
+// String - rule of a string value, see Rule.String.
+func String() Rule {
+	return Rule{}.String()
+}
+
+// Int - rule of an integer value, see Rule.Int.
+func Int() Rule {
+	return Rule{}.Int()
+}
+
+// Float - rule of a number value, see Rule.Float.
+func Float() Rule {
+	return Rule{}.Float()
+}
+
+// Bool - rule of a boolean value, see Rule.Bool.
+func Bool() Rule {
+	return Rule{}.Bool()
+}
+
+// Slice - rule of a slice value, see Rule.Slice.
+func Slice() Rule {
+	return Rule{}.Slice()
+}
+
+// Map - rule of a map value, see Rule.Map.
+func Map() Rule {
+	return Rule{}.Map()
+}
+
+// OneOf - rule of an enumerated value, see Rule.OneOf.
+func OneOf(values ...interface{}) Rule {
+	return Rule{}.OneOf(values...)
+}
+
+// Required - value must exist and not be null.
+func (r Rule) Required() Rule {
+	r.required = true
+	return r
+}
+
+// String - value must be a string or can be cast (IsString).
+func (r Rule) String() Rule {
+	return r.with("string", func(o object.Object) string {
+		if !o.IsString() {
+			return "value isn't a string"
+		}
+		return ""
+	})
+}
+
+// Int - value must be an integer or can be cast lossless (IsInt).
+func (r Rule) Int() Rule {
+	return r.with("int", func(o object.Object) string {
+		if !o.IsInt() {
+			return "value isn't an integer"
+		}
+		return ""
+	})
+}
+
+// Float - value must be a number or can be cast (IsFloat).
+func (r Rule) Float() Rule {
+	return r.with("float", func(o object.Object) string {
+		if !o.IsFloat() {
+			return "value isn't a number"
+		}
+		return ""
+	})
+}
+
+// Bool - value must be a boolean or can be cast (IsBool).
+func (r Rule) Bool() Rule {
+	return r.with("bool", func(o object.Object) string {
+		if !o.IsBool() {
+			return "value isn't a boolean"
+		}
+		return ""
+	})
+}
+
+// Slice - value must be a slice.
+func (r Rule) Slice() Rule {
+	return r.with("slice", func(o object.Object) string {
+		if o.Type() != object.TypeArray {
+			return "value isn't a slice"
+		}
+		return ""
+	})
+}
+
+// Map - value must be a map.
+func (r Rule) Map() Rule {
+	return r.with("map", func(o object.Object) string {
+		if o.Type() != object.TypeMap {
+			return "value isn't a map"
+		}
+		return ""
+	})
+}
+
+// Min - number (or number in a string) must be greater than or equal to the limit.
+func (r Rule) Min(limit float64) Rule {
+	return r.with("min", func(o object.Object) string {
+		if f, ok := floatOf(o); !ok || f < limit {
+			return fmt.Sprintf("value must be at least %v", limit)
+		}
+		return ""
+	})
+}
+
+// Max - number (or number in a string) must be less than or equal to the limit.
+func (r Rule) Max(limit float64) Rule {
+	return r.with("max", func(o object.Object) string {
+		if f, ok := floatOf(o); !ok || f > limit {
+			return fmt.Sprintf("value must be at most %v", limit)
+		}
+		return ""
+	})
+}
+
+// MinLen - length of a string (in runes), slice or map must be
+// greater than or equal to the limit.
+func (r Rule) MinLen(limit int) Rule {
+	return r.with("min_len", func(o object.Object) string {
+		if length := lengthOf(o); length < limit {
+			return fmt.Sprintf("length %d is less than %d", length, limit)
+		}
+		return ""
+	})
+}
+
+// MaxLen - length of a string (in runes), slice or map must be
+// less than or equal to the limit.
+func (r Rule) MaxLen(limit int) Rule {
+	return r.with("max_len", func(o object.Object) string {
+		if length := lengthOf(o); length > limit {
+			return fmt.Sprintf("length %d is greater than %d", length, limit)
+		}
+		return ""
+	})
+}
+
+// Match - string must match the regular expression.
+func (r Rule) Match(re *regexp.Regexp) Rule {
+	return r.with("match", func(o object.Object) string {
+		if !re.MatchString(stringOf(o)) {
+			return fmt.Sprintf("value doesn't match %q", re.String())
+		}
+		return ""
+	})
+}
+
+// OneOf - value must be equal (like in object.Equal) to one of the values.
+func (r Rule) OneOf(values ...interface{}) Rule {
+	return r.with("one_of", func(o object.Object) string {
+		for _, value := range values {
+			if object.Equal(o, object.New(value)) {
+				return ""
+			}
+		}
+		return "value isn't one of the allowed values"
+	})
+}
+
+// Custom - value must pass the function, the returned error is a violation.
+func (r Rule) Custom(name string, fn func(o object.Object) error) Rule {
+	return r.with(name, func(o object.Object) string {
+		if err := fn(o); err != nil {
+			return err.Error()
+		}
+		return ""
+	})
+}
+
+// floatOf - value of a number or a string with a number.
+func floatOf(o object.Object) (float64, bool) {
+	switch o.Type() {
+	case object.TypeInteger, object.TypeFloat, object.TypeString:
+	default:
+		return 0, false
+	}
+	s, err := o.StringWith(object.CoercionLenient)
+	if err != nil {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
+
+// stringOf - string value or formatted value which can't be cast.
+func stringOf(o object.Object) string {
+	if s, err := o.StringWith(object.CoercionLenient); err == nil {
+		return s
+	}
+	return fmt.Sprint(o.ToValue())
+}
+
+// lengthOf - runes count of strings, length of slices, maps and bytes.
+func lengthOf(o object.Object) int {
+	switch o.Type() {
+	case object.TypeArray, object.TypeMap:
+		return o.Len()
+	case object.TypeBytes:
+		return len(stringOf(o))
+	}
+	return utf8.RuneCountInString(stringOf(o))
+}
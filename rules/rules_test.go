@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"errors"
+	"github.com/the-go-tool/object"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// ruleErrors - errors as "path:rule" lines.
+func ruleErrors(errs []ValidationError) string {
+	lines := make([]string, 0, len(errs))
+	for _, e := range errs {
+		lines = append(lines, e.Path.String()+":"+e.Rule)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestRules_Validate(t *testing.T) {
+	set := Rules{
+		"a.b":        Required().String().MaxLen(5),
+		"e[*]":       Int().Min(0),
+		"tags":       Slice().MaxLen(2),
+		"users[*].n": Required().Match(regexp.MustCompile(`^[a-z]+$`)),
+		"mode":       OneOf("on", "off"),
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		obj := object.NewFromYaml([]byte(`
+a: {b: hello}
+e: [0, "5", 7.0]
+users: [{n: ann}, {n: bob}]
+mode: on
+`))
+		if errs := set.Validate(obj); len(errs) != 0 {
+			t.Fatalf(`unexpected errors: %v`, errs)
+		}
+	})
+	t.Run("all errors", func(t *testing.T) {
+		obj := object.NewFromJson([]byte(`{
+			"a": {"b": "too long"},
+			"e": [1, -1, 2.5, "x"],
+			"tags": "not a slice",
+			"users": [{"n": "Ann"}, {}],
+			"mode": "auto"
+		}`))
+		expected := strings.Join([]string{
+			"a.b:max_len",
+			"e[1]:min",
+			"e[2]:int",
+			"e[3]:int",
+			"mode:one_of",
+			"tags:slice",
+			"users[0].n:match",
+			"users[1].n:required",
+		}, "\n")
+		if result := ruleErrors(set.Validate(obj)); result != expected {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("missing and null", func(t *testing.T) {
+		errs := Rules{"a": Required(), "b": Required(), "c": Int(), "d": Int()}.Validate(object.NewFromJson([]byte(`{"b":null,"d":null}`)))
+		if result := ruleErrors(errs); result != "a:required\nb:required" {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("custom", func(t *testing.T) {
+		even := Int().Custom("even", func(o object.Object) error {
+			if f, _ := floatOf(o); int(f)%2 != 0 {
+				return errors.New("value must be even")
+			}
+			return nil
+		})
+		errs := Rules{"[*]": even}.Validate(object.New([]int{2, 3}))
+		if len(errs) != 1 || errs[0].Error() != "[1]: value must be even" {
+			t.Fatalf(`unexpected errors: %v`, errs)
+		}
+	})
+	t.Run("rules are immutable", func(t *testing.T) {
+		base := Required().Float()
+		small, big := base.Max(10), base.Min(100)
+		if result := ruleErrors(Rules{"a": small, "b": big}.Validate(object.New(map[string]interface{}{"a": 50, "b": 50}))); result != "a:max\nb:min" {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("types", func(t *testing.T) {
+		obj := object.New(map[string]interface{}{"b": []byte("abcd"), "m": map[string]int{"a": 1}, "n": "1e3"})
+		errs := Rules{"b": Slice(), "m": Map().MaxLen(1), "n": Float().Max(100)}.Validate(obj)
+		if result := ruleErrors(errs); result != "b:slice\nn:max" {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+		if result := ruleErrors(Rules{"b": Required().MaxLen(3)}.Validate(obj)); result != "b:max_len" {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("invalid path", func(t *testing.T) {
+		if result := ruleErrors(Rules{"a[": Required()}.Validate(object.New(1))); result != `["a["]:path` {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+}
@@ -0,0 +1,190 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/the-go-tool/object"
+	"sort"
+)
+
+// InferOptions - options of the schema inference.
+type InferOptions struct {
+	// EnumLimit - max count of distinct strings to describe them as enum,
+	// 5 by default. Strings are enum only if some values are repeating.
+	// Negative value disables enums.
+	EnumLimit int
+	// Draft - draft of the inferred schema, Draft2020 by default.
+	Draft int
+}
+
+func (i InferOptions) enumLimit() int {
+	if i.EnumLimit == 0 {
+		return 5
+	}
+	return i.EnumLimit
+}
+
+func (i InferOptions) schemaUri() string {
+	if i.Draft == Draft07 {
+		return "http://json-schema.org/draft-07/schema#"
+	}
+	return "https://json-schema.org/draft/2020-12/schema"
+}
+
+// Infer - make a JSON Schema describing all the samples (like lines
+// of a NDJSON dump): types of values, required keys (present in all
+// samples), enums for low-cardinality strings and nullable values.
+// The result is valid for every sample and can be compiled with Compile.
+func Infer(samples ...object.Object) (object.Object, error) {
+	return InferWith(InferOptions{}, samples...)
+}
+
+// InferWith - acts like Infer but with options.
+func InferWith(options InferOptions, samples ...object.Object) (object.Object, error) {
+	root := newShape(options)
+	for i, sample := range samples {
+		if err := sample.GetError(); err != nil {
+			return object.Object{}, fmt.Errorf("sample %d: %w", i, err)
+		}
+		value, err := plain(sample)
+		if err != nil {
+			return object.Object{}, fmt.Errorf("sample %d: %w", i, err)
+		}
+		root.add(value)
+	}
+	result := root.schema()
+	result["$schema"] = options.schemaUri()
+	return object.New(result), nil
+}
+
+// shape - accumulated description of observed values.
+type shape struct {
+	options InferOptions
+	types   map[string]bool
+	// strings - distinct observed strings, nil when there are too many.
+	strings     map[string]bool
+	stringCount int
+	objectCount int
+	props       map[string]*shape
+	propCount   map[string]int
+	items       *shape
+}
+
+func newShape(options InferOptions) *shape {
+	return &shape{
+		options: options,
+		types:   make(map[string]bool),
+		strings: make(map[string]bool),
+	}
+}
+
+// add - observe the json value.
+func (s *shape) add(v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		s.types["null"] = true
+	case bool:
+		s.types["boolean"] = true
+	case json.Number:
+		if hasType(v, "integer") {
+			s.types["integer"] = true
+		} else {
+			s.types["number"] = true
+		}
+	case string:
+		s.types["string"] = true
+		s.stringCount++
+		if s.strings != nil {
+			s.strings[v] = true
+			if len(s.strings) > s.options.enumLimit() {
+				s.strings = nil
+			}
+		}
+	case []interface{}:
+		s.types["array"] = true
+		for _, item := range v {
+			if s.items == nil {
+				s.items = newShape(s.options)
+			}
+			s.items.add(item)
+		}
+	case map[string]interface{}:
+		s.types["object"] = true
+		s.objectCount++
+		if s.props == nil {
+			s.props = make(map[string]*shape)
+			s.propCount = make(map[string]int)
+		}
+		for key, value := range v {
+			if s.props[key] == nil {
+				s.props[key] = newShape(s.options)
+			}
+			s.props[key].add(value)
+			s.propCount[key]++
+		}
+	}
+}
+
+// schema - JSON Schema of the observed values.
+func (s *shape) schema() map[string]interface{} {
+	result := make(map[string]interface{})
+	if s.types["integer"] && s.types["number"] {
+		delete(s.types, "integer")
+	}
+	types := make([]interface{}, 0, len(s.types))
+	for _, name := range []string{"object", "array", "string", "integer", "number", "boolean", "null"} {
+		if s.types[name] {
+			types = append(types, name)
+		}
+	}
+	switch len(types) {
+	case 0:
+		return result
+	case 1:
+		result["type"] = types[0]
+	default:
+		result["type"] = types
+	}
+
+	// enums are only for strings, they can be nullable
+	onlyStrings := len(types) == 1 || len(types) == 2 && s.types["null"]
+	if s.types["string"] && onlyStrings && s.strings != nil && s.options.enumLimit() > 0 && s.stringCount > len(s.strings) {
+		values := make([]string, 0, len(s.strings))
+		for value := range s.strings {
+			values = append(values, value)
+		}
+		sort.Strings(values)
+		enum := make([]interface{}, 0, len(values)+1)
+		for _, value := range values {
+			enum = append(enum, value)
+		}
+		if s.types["null"] {
+			enum = append(enum, nil)
+		}
+		result["enum"] = enum
+	}
+
+	if s.items != nil {
+		result["items"] = s.items.schema()
+	}
+	if s.props != nil {
+		properties := make(map[string]interface{}, len(s.props))
+		required := make([]string, 0, len(s.props))
+		for key, prop := range s.props {
+			properties[key] = prop.schema()
+			if s.propCount[key] == s.objectCount {
+				required = append(required, key)
+			}
+		}
+		sort.Strings(required)
+		result["properties"] = properties
+		if len(required) > 0 {
+			values := make([]interface{}, len(required))
+			for i, key := range required {
+				values[i] = key
+			}
+			result["required"] = values
+		}
+	}
+	return result
+}
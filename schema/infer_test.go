@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"bytes"
+	"github.com/the-go-tool/object"
+	"testing"
+)
+
+func TestInfer(t *testing.T) {
+	ndjson := []byte(`{"id":1,"status":"active","score":1,"tags":["a"],"owner":{"name":"ann"}}
+{"id":2,"status":"blocked","score":2.5,"tags":[],"owner":null,"note":"first"}
+{"id":3,"status":"active","score":3,"tags":["b","c"],"owner":{"name":"bob","age":30}}
+{"id":4,"status":null,"score":4,"tags":["a"],"owner":{"name":"eve"},"note":"second"}`)
+	samples := make([]object.Object, 0)
+	for _, line := range bytes.Split(ndjson, []byte("\n")) {
+		samples = append(samples, object.NewFromJson(line))
+	}
+
+	inferred, err := Infer(samples...)
+	if err != nil {
+		t.Fatalf(`unexpected error: %v`, err)
+	}
+
+	t.Run("schema", func(t *testing.T) {
+		expected := `{"$schema":"https://json-schema.org/draft/2020-12/schema",` +
+			`"properties":{` +
+			`"id":{"type":"integer"},` +
+			`"note":{"type":"string"},` +
+			`"owner":{"properties":{"age":{"type":"integer"},"name":{"type":"string"}},"required":["name"],"type":["object","null"]},` +
+			`"score":{"type":"number"},` +
+			`"status":{"enum":["active","blocked",null],"type":["string","null"]},` +
+			`"tags":{"items":{"enum":["a","b","c"],"type":"string"},"type":"array"}},` +
+			`"required":["id","owner","score","status","tags"],` +
+			`"type":"object"}`
+		data, _ := inferred.ToJson()
+		if string(data) != expected {
+			t.Fatalf(`unexpected schema: %s`, data)
+		}
+	})
+	t.Run("validates samples", func(t *testing.T) {
+		s, err := Compile(inferred)
+		if err != nil {
+			t.Fatalf(`unexpected compile error: %v`, err)
+		}
+		for i, sample := range samples {
+			if errs := s.Validate(sample); len(errs) != 0 {
+				t.Fatalf(`unexpected errors of sample %d: %v`, i, errs)
+			}
+		}
+		if errs := s.Validate(object.NewFromJson([]byte(`{"id":1.5,"status":"x","score":1,"tags":[],"owner":null}`))); len(errs) != 2 {
+			t.Fatalf(`unexpected errors: %v`, errs)
+		}
+	})
+	t.Run("options", func(t *testing.T) {
+		inferred, _ := InferWith(InferOptions{EnumLimit: -1, Draft: Draft07}, samples[0], samples[2])
+		data, _ := inferred.Get("properties").Get("status").ToJson()
+		if string(data) != `{"type":"string"}` {
+			t.Fatalf(`unexpected status schema: %s`, data)
+		}
+		if uri := inferred.Get("$schema").ToValue(); uri != "http://json-schema.org/draft-07/schema#" {
+			t.Fatalf(`unexpected $schema: %v`, uri)
+		}
+	})
+	t.Run("unique strings", func(t *testing.T) {
+		inferred, _ := Infer(object.New("a"), object.New("b"))
+		if data, _ := inferred.ToJson(); string(data) != `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"string"}` {
+			t.Fatalf(`unexpected schema: %s`, data)
+		}
+	})
+	t.Run("invalid sample", func(t *testing.T) {
+		if _, err := Infer(object.NewFromJson([]byte(`{`))); err == nil {
+			t.Fatalf(`expected error`)
+		}
+	})
+}
@@ -0,0 +1,358 @@
+// Package schema validates objects against JSON Schema (draft 2020-12 and draft-07).
+//
+// Schemas can be written in any format supported by object.NewFromData
+// (JSON, YAML, TOML), so one validator works for documents of all formats:
+//
+//	s, err := schema.CompileFile("config.schema.json")
+//	...
+//	for _, e := range s.Validate(object.NewFromData(data)) {
+//		fmt.Println(e.InstancePath, e.Message)
+//	}
+//
+// References ($ref) can point to $defs (definitions), anchors and
+// local files (relative to the referencing file). Remote references
+// and "format" assertions aren't supporting.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/the-go-tool/object"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Supported drafts of JSON Schema.
+const (
+	Draft07   = 7
+	Draft2020 = 2020
+)
+
+// Schema - compiled JSON Schema.
+type Schema struct {
+	root    *document
+	docs    map[string]*document
+	regexps map[string]*regexp.Regexp
+}
+
+// ValidationError - single violation of the schema.
+type ValidationError struct {
+	// InstancePath - path of the invalid value in the validating object.
+	InstancePath object.Path
+	// SchemaPath - location of the failed keyword like "#/properties/a/type".
+	// References are resolving, so the location is in the referenced
+	// schema and it's prefixed with the file name for other files.
+	SchemaPath string
+	// Message - human-readable description of the violation.
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.InstancePath, e.Message)
+}
+
+// document - loaded schema document (the root one or a referenced file).
+type document struct {
+	file    string
+	id      string
+	root    interface{}
+	draft   int
+	anchors map[string]string // anchor name -> json pointer
+}
+
+// Compile - compile the schema object. Relative file references
+// are resolving from the working directory.
+func Compile(schema object.Object) (*Schema, error) {
+	root, err := plain(schema)
+	if err != nil {
+		return nil, err
+	}
+	s := newSchema()
+	if s.root, err = s.add("", root, Draft2020); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CompileFile - read and compile the schema file of any format supported
+// by object.NewFromData. Relative file references are resolving from
+// the file's directory.
+func CompileFile(path string) (*Schema, error) {
+	s := newSchema()
+	var err error
+	if s.root, err = s.load(path, Draft2020); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newSchema() *Schema {
+	return &Schema{
+		docs:    make(map[string]*document),
+		regexps: make(map[string]*regexp.Regexp),
+	}
+}
+
+// Draft - draft of the root schema detected by its "$schema" keyword,
+// Draft2020 by default.
+func (s *Schema) Draft() int {
+	return s.root.draft
+}
+
+// Validate - validate the object and return all violations.
+// Empty result means the object is valid.
+func (s *Schema) Validate(obj object.Object) []ValidationError {
+	instance, err := plain(obj)
+	if err != nil {
+		return []ValidationError{{InstancePath: object.Path{}, SchemaPath: "#", Message: err.Error()}}
+	}
+	v := validator{schema: s}
+	result := v.validate(s.root, s.root.root, "#", instance, object.Path{})
+	if result.errors == nil {
+		return []ValidationError{}
+	}
+	return result.errors
+}
+
+// plain - json representation of the object with exact numbers.
+func plain(obj object.Object) (interface{}, error) {
+	data, err := obj.ToJson()
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// load - load the schema file if it isn't loaded yet.
+func (s *Schema) load(path string, draft int) (*document, error) {
+	file, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if doc, ok := s.docs[file]; ok {
+		return doc, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	obj := object.NewFromData(data)
+	if obj.GetError() != nil {
+		return nil, fmt.Errorf("schema %s: %w", path, obj.GetError())
+	}
+	root, err := plain(obj)
+	if err != nil {
+		return nil, err
+	}
+	return s.add(file, root, draft)
+}
+
+// add - register the document, check its keywords and resolve references.
+func (s *Schema) add(file string, root interface{}, draft int) (*document, error) {
+	doc := &document{file: file, root: root, draft: draft, anchors: make(map[string]string)}
+	if m, ok := root.(map[string]interface{}); ok {
+		if uri, ok := m["$schema"].(string); ok {
+			doc.draft = detectDraft(uri)
+		}
+		if id, ok := m["$id"].(string); ok {
+			doc.id = strings.TrimSuffix(id, "#")
+		}
+	} else if _, ok := root.(bool); !ok {
+		return nil, errors.New("schema must be an object or a boolean")
+	}
+	s.docs[file] = doc
+
+	refs := make([]string, 0)
+	if err := s.prepare(doc, root, "#", &refs); err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		if _, _, _, err := s.resolve(doc, ref); err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// detectDraft - draft by the "$schema" uri.
+func detectDraft(uri string) int {
+	for _, old := range []string{"draft-07", "draft-06", "draft-04"} {
+		if strings.Contains(uri, old) {
+			return Draft07
+		}
+	}
+	return Draft2020
+}
+
+// Keywords containing subschemas.
+var (
+	schemaKeywords      = []string{"additionalProperties", "propertyNames", "additionalItems", "contains", "not", "if", "then", "else", "unevaluatedProperties", "unevaluatedItems"}
+	schemaMapKeywords   = []string{"properties", "patternProperties", "$defs", "definitions", "dependentSchemas", "dependencies"}
+	schemaArrayKeywords = []string{"allOf", "anyOf", "oneOf", "prefixItems", "items"}
+)
+
+// prepare - compile patterns, collect anchors and references of the schema.
+func (s *Schema) prepare(doc *document, schema interface{}, pointer string, refs *[]string) error {
+	m, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if anchor, ok := m["$anchor"].(string); ok {
+		doc.anchors[anchor] = pointer
+	}
+	if id, ok := m["$id"].(string); ok && strings.HasPrefix(id, "#") {
+		doc.anchors[id[1:]] = pointer
+	}
+	if ref, ok := m["$ref"].(string); ok {
+		*refs = append(*refs, ref)
+	}
+	if pattern, ok := m["pattern"].(string); ok {
+		if err := s.compilePattern(pattern); err != nil {
+			return err
+		}
+	}
+	if patterns, ok := m["patternProperties"].(map[string]interface{}); ok {
+		for pattern := range patterns {
+			if err := s.compilePattern(pattern); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, keyword := range schemaKeywords {
+		if sub, ok := m[keyword]; ok {
+			if err := s.prepare(doc, sub, pointer+"/"+keyword, refs); err != nil {
+				return err
+			}
+		}
+	}
+	for _, keyword := range schemaMapKeywords {
+		subs, _ := m[keyword].(map[string]interface{})
+		for key, sub := range subs {
+			if err := s.prepare(doc, sub, pointer+"/"+keyword+"/"+escape(key), refs); err != nil {
+				return err
+			}
+		}
+	}
+	for _, keyword := range schemaArrayKeywords {
+		switch subs := m[keyword].(type) {
+		case []interface{}:
+			for i, sub := range subs {
+				if err := s.prepare(doc, sub, pointer+"/"+keyword+"/"+strconv.Itoa(i), refs); err != nil {
+					return err
+				}
+			}
+		case map[string]interface{}, bool:
+			if err := s.prepare(doc, subs, pointer+"/"+keyword, refs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) compilePattern(pattern string) error {
+	if _, ok := s.regexps[pattern]; ok {
+		return nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("schema pattern %q: %w", pattern, err)
+	}
+	s.regexps[pattern] = re
+	return nil
+}
+
+// resolve - find the schema referenced from the document.
+// Returns the target document, the schema and its json pointer.
+func (s *Schema) resolve(doc *document, ref string) (*document, interface{}, string, error) {
+	file, fragment := ref, ""
+	if i := strings.IndexByte(ref, '#'); i >= 0 {
+		file, fragment = ref[:i], ref[i+1:]
+	}
+
+	target := doc
+	if file != "" && file != doc.id {
+		target = nil
+		for _, d := range s.docs {
+			if d.id != "" && d.id == file {
+				target = d
+			}
+		}
+		if target == nil {
+			if strings.Contains(file, "://") {
+				return nil, nil, "", fmt.Errorf("schema reference %q: remote references aren't supporting", ref)
+			}
+			path := file
+			if !filepath.IsAbs(path) && doc.file != "" {
+				path = filepath.Join(filepath.Dir(doc.file), path)
+			}
+			var err error
+			if target, err = s.load(path, doc.draft); err != nil {
+				return nil, nil, "", fmt.Errorf("schema reference %q: %w", ref, err)
+			}
+		}
+	}
+
+	pointer := "#" + fragment
+	if fragment != "" && fragment[0] != '/' {
+		anchor, ok := target.anchors[fragment]
+		if !ok {
+			return nil, nil, "", fmt.Errorf("schema reference %q: anchor not found", ref)
+		}
+		pointer = anchor
+	}
+	schema, err := evaluatePointer(target.root, pointer[1:])
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("schema reference %q: %w", ref, err)
+	}
+	return target, schema, pointer, nil
+}
+
+// evaluatePointer - get the value by url-encoded JSON Pointer.
+func evaluatePointer(root interface{}, pointer string) (interface{}, error) {
+	pointer, err := url.PathUnescape(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if pointer == "" {
+		return root, nil
+	}
+	value := root
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[token]; !ok {
+				return nil, errors.New("pointer target not found")
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, errors.New("pointer target not found")
+			}
+			value = v[index]
+		default:
+			return nil, errors.New("pointer target not found")
+		}
+	}
+	return value, nil
+}
+
+// escape - escape the key to be a JSON Pointer reference token.
+func escape(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,213 @@
+package schema
+
+import (
+	"github.com/the-go-tool/object"
+	"strings"
+	"testing"
+)
+
+// messages - errors as "instance path|schema path" lines.
+func messages(errs []ValidationError) string {
+	lines := make([]string, 0, len(errs))
+	for _, e := range errs {
+		lines = append(lines, e.InstancePath.String()+"|"+e.SchemaPath)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func mustCompile(t *testing.T, schema string) *Schema {
+	s, err := Compile(object.NewFromData([]byte(schema)))
+	if err != nil {
+		t.Fatalf(`unexpected compile error: %v`, err)
+	}
+	return s
+}
+
+func TestCompileFile(t *testing.T) {
+	s, err := CompileFile("testdata/config.schema.yaml")
+	if err != nil {
+		t.Fatalf(`unexpected compile error: %v`, err)
+	}
+	if s.Draft() != Draft2020 {
+		t.Fatalf(`unexpected draft: %d`, s.Draft())
+	}
+
+	t.Run("valid yaml", func(t *testing.T) {
+		errs := s.Validate(object.NewFromData([]byte("name: app\nserver: {host: example.com, port: 80}\ntags: [a, b]\n")))
+		if len(errs) != 0 {
+			t.Fatalf(`unexpected errors: %v`, errs)
+		}
+	})
+	t.Run("valid toml", func(t *testing.T) {
+		errs := s.Validate(object.NewFromToml([]byte("name = \"app\"\n[server]\nport = 8080\n")))
+		if len(errs) != 0 {
+			t.Fatalf(`unexpected errors: %v`, errs)
+		}
+	})
+	t.Run("all errors", func(t *testing.T) {
+		errs := s.Validate(object.NewFromData([]byte(`{"name":"","server":{"host":"Bad Host","port":70000.5},"tags":["a","a"],"extra":1}`)))
+		expected := strings.Join([]string{
+			`extra|#/additionalProperties`,
+			`name|#/properties/name/minLength`,
+			`server.host|server.schema.json#/$defs/host/pattern`,
+			`server.port|server.schema.json#/properties/port/type`,
+			`server.port|server.schema.json#/properties/port/maximum`,
+			`tags|#/properties/tags/uniqueItems`,
+		}, "\n")
+		if result := messages(errs); result != expected {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := CompileFile("testdata/missing.json"); err == nil {
+			t.Fatalf(`expected error`)
+		}
+	})
+}
+
+func TestValidate_Draft2020(t *testing.T) {
+	t.Run("defs and anchors", func(t *testing.T) {
+		s := mustCompile(t, `{
+			"type": "array",
+			"prefixItems": [{"$ref": "#/$defs/id"}, {"$ref": "#name"}],
+			"items": false,
+			"$defs": {
+				"id": {"type": "integer"},
+				"name": {"$anchor": "name", "type": "string"}
+			}
+		}`)
+		if errs := s.Validate(object.New([]interface{}{1, "a"})); len(errs) != 0 {
+			t.Fatalf(`unexpected errors: %v`, errs)
+		}
+		errs := s.Validate(object.New([]interface{}{1.5, 2, true}))
+		expected := "[0]|#/$defs/id/type\n[1]|#/$defs/name/type\n[2]|#/items"
+		if result := messages(errs); result != expected {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("recursive", func(t *testing.T) {
+		s := mustCompile(t, `{
+			"type": "object",
+			"properties": {"children": {"type": "array", "items": {"$ref": "#"}}},
+			"required": ["id"]
+		}`)
+		errs := s.Validate(object.NewFromJson([]byte(`{"id":1,"children":[{"id":2},{"children":[{}]}]}`)))
+		expected := "children[1]|#/required\nchildren[1].children[0]|#/required"
+		if result := messages(errs); result != expected {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+	t.Run("applicators", func(t *testing.T) {
+		s := mustCompile(t, `{
+			"oneOf": [{"type": "integer"}, {"type": "number", "multipleOf": 0.5}],
+			"not": {"const": 3}
+		}`)
+		cases := map[string]string{
+			`1`:    "|#/oneOf",
+			`1.5`:  "",
+			`1.25`: "|#/oneOf",
+			`3`:    "|#/oneOf\n|#/not",
+		}
+		for data, expected := range cases {
+			if result := messages(s.Validate(object.NewFromJson([]byte(data)))); result != expected {
+				t.Fatalf(`unexpected errors for %s: %s`, data, result)
+			}
+		}
+	})
+	t.Run("conditional and unevaluated", func(t *testing.T) {
+		s := mustCompile(t, `{
+			"properties": {"kind": {"enum": ["file", "url"]}},
+			"if": {"properties": {"kind": {"const": "file"}}},
+			"then": {"properties": {"path": {"type": "string"}}, "required": ["path"]},
+			"else": {"properties": {"url": {"type": "string"}}, "required": ["url"]},
+			"unevaluatedProperties": false
+		}`)
+		cases := map[string]string{
+			`{"kind":"file","path":"a"}`:         "",
+			`{"kind":"url","url":"a"}`:           "",
+			`{"kind":"url","path":"a"}`:          "|#/else/required\npath|#/unevaluatedProperties",
+			`{"kind":"file","path":"a","url":1}`: "url|#/unevaluatedProperties",
+		}
+		for data, expected := range cases {
+			if result := messages(s.Validate(object.NewFromJson([]byte(data)))); result != expected {
+				t.Fatalf(`unexpected errors for %s: %s`, data, result)
+			}
+		}
+	})
+	t.Run("contains", func(t *testing.T) {
+		s := mustCompile(t, `{"contains": {"type": "string"}, "minContains": 2, "maxContains": 3}`)
+		cases := map[string]string{
+			`["a", 1, "b"]`:      "",
+			`["a", 1]`:           "|#/contains",
+			`["a","b","c","d"]`:  "|#/maxContains",
+			`{"not": "a slice"}`: "",
+		}
+		for data, expected := range cases {
+			if result := messages(s.Validate(object.NewFromJson([]byte(data)))); result != expected {
+				t.Fatalf(`unexpected errors for %s: %s`, data, result)
+			}
+		}
+	})
+	t.Run("objects", func(t *testing.T) {
+		s := mustCompile(t, `{
+			"patternProperties": {"^x-": {"type": "string"}},
+			"propertyNames": {"maxLength": 5},
+			"dependentRequired": {"a": ["b"]},
+			"maxProperties": 2
+		}`)
+		errs := s.Validate(object.NewFromJson([]byte(`{"x-abc":1,"a":1,"long-name":1}`)))
+		expected := "|#/maxProperties\n|#/propertyNames\nx-abc|#/patternProperties/^x-/type\n|#/dependentRequired/a"
+		if result := messages(errs); result != expected {
+			t.Fatalf(`unexpected errors: %s`, result)
+		}
+	})
+}
+
+func TestValidate_Draft07(t *testing.T) {
+	s := mustCompile(t, `{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"definitions": {"port": {"type": "integer", "exclusiveMinimum": 0}},
+		"properties": {
+			"ports": {"items": [{"$ref": "#/definitions/port"}], "additionalItems": {"type": "string"}},
+			"ref": {"$ref": "#/definitions/port", "type": "string"}
+		},
+		"dependencies": {"a": ["b"], "c": {"required": ["d"]}}
+	}`)
+	if s.Draft() != Draft07 {
+		t.Fatalf(`unexpected draft: %d`, s.Draft())
+	}
+	if errs := s.Validate(object.NewFromJson([]byte(`{"ports":[80,"x"],"ref":1,"a":1,"b":2}`))); len(errs) != 0 {
+		t.Fatalf(`unexpected errors: %v`, errs)
+	}
+	errs := s.Validate(object.NewFromJson([]byte(`{"ports":[0,1],"a":1,"c":1}`)))
+	expected := "ports[0]|#/definitions/port/exclusiveMinimum\nports[1]|#/properties/ports/additionalItems/type\n|#/dependencies/a\n|#/dependencies/c/required"
+	if result := messages(errs); result != expected {
+		t.Fatalf(`unexpected errors: %s`, result)
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	cases := map[string]string{
+		"bad pattern":   `{"pattern": "("}`,
+		"bad ref":       `{"$ref": "#/$defs/missing"}`,
+		"bad anchor":    `{"$ref": "#missing"}`,
+		"remote ref":    `{"$ref": "https://example.com/schema.json"}`,
+		"not an object": `[1, 2]`,
+	}
+	for name, schema := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Compile(object.NewFromJson([]byte(schema))); err == nil {
+				t.Fatalf(`expected error`)
+			}
+		})
+	}
+}
+
+func TestValidate_Booleans(t *testing.T) {
+	if errs := mustCompile(t, `true`).Validate(object.New(1)); len(errs) != 0 {
+		t.Fatalf(`unexpected errors: %v`, errs)
+	}
+	if errs := mustCompile(t, `false`).Validate(object.New(1)); messages(errs) != "|#" {
+		t.Fatalf(`unexpected errors: %v`, errs)
+	}
+}
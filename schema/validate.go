@@ -0,0 +1,542 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/the-go-tool/object"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxDepth - limit of nested references to stop infinite recursion.
+const maxDepth = 512
+
+// validator - validation state.
+type validator struct {
+	schema *Schema
+	depth  int
+}
+
+// result - errors and annotations of the schema validation.
+// Evaluated properties and items are used by unevaluated* keywords.
+type result struct {
+	errors   []ValidationError
+	props    map[string]bool
+	items    map[int]bool
+	allItems bool
+}
+
+func (r *result) fail(path object.Path, pointer, format string, args ...interface{}) {
+	r.errors = append(r.errors, ValidationError{
+		InstancePath: path,
+		SchemaPath:   pointer,
+		Message:      fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *result) valid() bool {
+	return len(r.errors) == 0
+}
+
+// merge - add errors of the subschema result and, if it's valid,
+// its annotations.
+func (r *result) merge(sub result) {
+	r.errors = append(r.errors, sub.errors...)
+	if !sub.valid() {
+		return
+	}
+	r.annotate(sub)
+}
+
+// annotate - add annotations of the subschema result.
+func (r *result) annotate(sub result) {
+	for key := range sub.props {
+		r.evaluateProp(key)
+	}
+	for index := range sub.items {
+		r.evaluateItem(index)
+	}
+	r.allItems = r.allItems || sub.allItems
+}
+
+func (r *result) evaluateProp(key string) {
+	if r.props == nil {
+		r.props = make(map[string]bool)
+	}
+	r.props[key] = true
+}
+
+func (r *result) evaluateItem(index int) {
+	if r.items == nil {
+		r.items = make(map[int]bool)
+	}
+	r.items[index] = true
+}
+
+// location - schema path prefix of the document.
+func (v *validator) location(doc *document, pointer string) string {
+	if doc == v.schema.root || doc.file == "" {
+		return pointer
+	}
+	return filepath.Base(doc.file) + pointer
+}
+
+// validate - validate the instance at the path against the schema
+// located by the pointer in the document.
+func (v *validator) validate(doc *document, schema interface{}, pointer string, instance interface{}, path object.Path) result {
+	var r result
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			r.fail(path, v.location(doc, pointer), "no values are allowed")
+		}
+		return r
+	case map[string]interface{}:
+		v.depth++
+		defer func() { v.depth-- }()
+		if v.depth > maxDepth {
+			r.fail(path, v.location(doc, pointer), "references are too deep")
+			return r
+		}
+		k := keywords{v: v, doc: doc, schema: s, pointer: pointer, instance: instance, path: path, r: &r}
+		k.validate()
+	}
+	return r
+}
+
+// keywords - validation of the schema object keywords.
+type keywords struct {
+	v        *validator
+	doc      *document
+	schema   map[string]interface{}
+	pointer  string
+	instance interface{}
+	path     object.Path
+	r        *result
+}
+
+func (k *keywords) at(keyword string) string {
+	return k.v.location(k.doc, k.pointer+"/"+keyword)
+}
+
+func (k *keywords) fail(keyword, format string, args ...interface{}) {
+	k.r.fail(k.path, k.at(keyword), format, args...)
+}
+
+// sub - validate the instance against the subschema of the keyword.
+func (k *keywords) sub(keyword string, schema interface{}, instance interface{}, path object.Path) result {
+	return k.v.validate(k.doc, schema, k.pointer+"/"+keyword, instance, path)
+}
+
+func (k *keywords) validate() {
+	if ref, ok := k.schema["$ref"].(string); ok {
+		k.ref(ref)
+		if k.doc.draft == Draft07 {
+			return
+		}
+	}
+	k.generic()
+	k.numeric()
+	k.text()
+	k.array()
+	k.properties()
+	k.applicators()
+	k.unevaluated()
+}
+
+func (k *keywords) ref(ref string) {
+	doc, schema, pointer, err := k.v.schema.resolve(k.doc, ref)
+	if err != nil {
+		k.fail("$ref", "%v", err)
+		return
+	}
+	k.r.merge(k.v.validate(doc, schema, pointer, k.instance, k.path))
+}
+
+// generic - type, enum and const.
+func (k *keywords) generic() {
+	switch types := k.schema["type"].(type) {
+	case string:
+		if !hasType(k.instance, types) {
+			k.fail("type", "expected %s, got %s", types, typeOf(k.instance))
+		}
+	case []interface{}:
+		names := make([]string, 0, len(types))
+		matched := false
+		for _, t := range types {
+			name, _ := t.(string)
+			names = append(names, name)
+			matched = matched || hasType(k.instance, name)
+		}
+		if !matched {
+			k.fail("type", "expected %s, got %s", strings.Join(names, " or "), typeOf(k.instance))
+		}
+	}
+	if enum, ok := k.schema["enum"].([]interface{}); ok {
+		found := false
+		for _, value := range enum {
+			found = found || equal(k.instance, value)
+		}
+		if !found {
+			k.fail("enum", "value isn't one of the allowed values")
+		}
+	}
+	if value, ok := k.schema["const"]; ok && !equal(k.instance, value) {
+		k.fail("const", "value isn't equal to the constant")
+	}
+}
+
+// numeric - numbers limits.
+func (k *keywords) numeric() {
+	n, ok := toRat(k.instance)
+	if !ok {
+		return
+	}
+	if divisor, ok := toRat(k.schema["multipleOf"]); ok && divisor.Sign() > 0 {
+		if !new(big.Rat).Quo(n, divisor).IsInt() {
+			k.fail("multipleOf", "%s isn't a multiple of %s", ratString(n), ratString(divisor))
+		}
+	}
+	if limit, ok := toRat(k.schema["maximum"]); ok && n.Cmp(limit) > 0 {
+		k.fail("maximum", "%s is greater than %s", ratString(n), ratString(limit))
+	}
+	if limit, ok := toRat(k.schema["minimum"]); ok && n.Cmp(limit) < 0 {
+		k.fail("minimum", "%s is less than %s", ratString(n), ratString(limit))
+	}
+	if limit, ok := toRat(k.schema["exclusiveMaximum"]); ok && n.Cmp(limit) >= 0 {
+		k.fail("exclusiveMaximum", "%s isn't less than %s", ratString(n), ratString(limit))
+	}
+	if limit, ok := toRat(k.schema["exclusiveMinimum"]); ok && n.Cmp(limit) <= 0 {
+		k.fail("exclusiveMinimum", "%s isn't greater than %s", ratString(n), ratString(limit))
+	}
+}
+
+// text - strings limits.
+func (k *keywords) text() {
+	s, ok := k.instance.(string)
+	if !ok {
+		return
+	}
+	length := utf8.RuneCountInString(s)
+	if limit, ok := toInt(k.schema["maxLength"]); ok && length > limit {
+		k.fail("maxLength", "length %d is greater than %d", length, limit)
+	}
+	if limit, ok := toInt(k.schema["minLength"]); ok && length < limit {
+		k.fail("minLength", "length %d is less than %d", length, limit)
+	}
+	if pattern, ok := k.schema["pattern"].(string); ok {
+		if re := k.v.schema.regexps[pattern]; re != nil && !re.MatchString(s) {
+			k.fail("pattern", "value doesn't match the pattern %q", pattern)
+		}
+	}
+}
+
+// array - items and arrays limits.
+func (k *keywords) array() {
+	items, ok := k.instance.([]interface{})
+	if !ok {
+		return
+	}
+
+	prefix, rest, restKeyword := 0, interface{}(nil), ""
+	if k.doc.draft == Draft07 {
+		if tuple, ok := k.schema["items"].([]interface{}); ok {
+			k.tuple("items", tuple, items)
+			prefix = len(tuple)
+			rest, restKeyword = k.schema["additionalItems"], "additionalItems"
+		} else {
+			rest, restKeyword = k.schema["items"], "items"
+		}
+	} else {
+		if tuple, ok := k.schema["prefixItems"].([]interface{}); ok {
+			k.tuple("prefixItems", tuple, items)
+			prefix = len(tuple)
+		}
+		rest, restKeyword = k.schema["items"], "items"
+	}
+	if rest != nil && prefix < len(items) {
+		for i := prefix; i < len(items); i++ {
+			k.r.merge(k.sub(restKeyword, rest, items[i], k.path.Append(strconv.Itoa(i))))
+		}
+		k.r.allItems = true
+	}
+
+	if contains, ok := k.schema["contains"]; ok {
+		matches := 0
+		for i, item := range items {
+			if sub := k.sub("contains", contains, item, k.path.Append(strconv.Itoa(i))); sub.valid() {
+				matches++
+				k.r.evaluateItem(i)
+			}
+		}
+		min, hasMin := toInt(k.schema["minContains"])
+		if !hasMin || k.doc.draft == Draft07 {
+			min = 1
+		}
+		if matches < min {
+			k.fail("contains", "array contains %d matching items, expected at least %d", matches, min)
+		}
+		if max, ok := toInt(k.schema["maxContains"]); ok && k.doc.draft != Draft07 && matches > max {
+			k.fail("maxContains", "array contains %d matching items, expected at most %d", matches, max)
+		}
+	}
+
+	if limit, ok := toInt(k.schema["maxItems"]); ok && len(items) > limit {
+		k.fail("maxItems", "array has %d items, expected at most %d", len(items), limit)
+	}
+	if limit, ok := toInt(k.schema["minItems"]); ok && len(items) < limit {
+		k.fail("minItems", "array has %d items, expected at least %d", len(items), limit)
+	}
+	if unique, _ := k.schema["uniqueItems"].(bool); unique {
+		for i := range items {
+			for j := 0; j < i; j++ {
+				if equal(items[i], items[j]) {
+					k.fail("uniqueItems", "items %d and %d are equal", j, i)
+				}
+			}
+		}
+	}
+}
+
+func (k *keywords) tuple(keyword string, tuple []interface{}, items []interface{}) {
+	for i, schema := range tuple {
+		if i >= len(items) {
+			break
+		}
+		k.r.merge(k.sub(keyword+"/"+strconv.Itoa(i), schema, items[i], k.path.Append(strconv.Itoa(i))))
+		k.r.evaluateItem(i)
+	}
+}
+
+// properties - properties and objects limits.
+func (k *keywords) properties() {
+	m, ok := k.instance.(map[string]interface{})
+	if !ok {
+		return
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if limit, ok := toInt(k.schema["maxProperties"]); ok && len(m) > limit {
+		k.fail("maxProperties", "object has %d properties, expected at most %d", len(m), limit)
+	}
+	if limit, ok := toInt(k.schema["minProperties"]); ok && len(m) < limit {
+		k.fail("minProperties", "object has %d properties, expected at least %d", len(m), limit)
+	}
+	if required, ok := k.schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, ok := m[name]; !ok {
+					k.fail("required", "missing required property %q", name)
+				}
+			}
+		}
+	}
+
+	properties, _ := k.schema["properties"].(map[string]interface{})
+	patterns, _ := k.schema["patternProperties"].(map[string]interface{})
+	additional, hasAdditional := k.schema["additionalProperties"]
+	names, hasNames := k.schema["propertyNames"]
+	for _, key := range keys {
+		path := k.path.Append(key)
+		matched := false
+		if schema, ok := properties[key]; ok {
+			k.r.merge(k.sub("properties/"+escape(key), schema, m[key], path))
+			matched = true
+		}
+		for pattern, schema := range patterns {
+			if re := k.v.schema.regexps[pattern]; re != nil && re.MatchString(key) {
+				k.r.merge(k.sub("patternProperties/"+escape(pattern), schema, m[key], path))
+				matched = true
+			}
+		}
+		if !matched && hasAdditional {
+			k.r.merge(k.sub("additionalProperties", additional, m[key], path))
+			matched = true
+		}
+		if matched {
+			k.r.evaluateProp(key)
+		}
+		if hasNames {
+			if sub := k.sub("propertyNames", names, key, path); !sub.valid() {
+				k.fail("propertyNames", "property name %q is invalid", key)
+			}
+		}
+	}
+
+	dependentRequired, _ := k.schema["dependentRequired"].(map[string]interface{})
+	dependentSchemas, _ := k.schema["dependentSchemas"].(map[string]interface{})
+	dependentKeyword := "dependentRequired"
+	if k.doc.draft == Draft07 {
+		dependentRequired, _ = k.schema["dependencies"].(map[string]interface{})
+		dependentSchemas, dependentKeyword = dependentRequired, "dependencies"
+	}
+	for _, key := range keys {
+		if names, ok := dependentRequired[key].([]interface{}); ok {
+			for _, name := range names {
+				if name, ok := name.(string); ok {
+					if _, ok := m[name]; !ok {
+						k.fail(dependentKeyword+"/"+escape(key), "property %q requires property %q", key, name)
+					}
+				}
+			}
+		}
+		if schema, ok := dependentSchemas[key]; ok {
+			if _, isNames := schema.([]interface{}); !isNames {
+				keyword := "dependentSchemas"
+				if k.doc.draft == Draft07 {
+					keyword = "dependencies"
+				}
+				k.r.merge(k.sub(keyword+"/"+escape(key), schema, k.instance, k.path))
+			}
+		}
+	}
+}
+
+// applicators - schema composition keywords.
+func (k *keywords) applicators() {
+	if schemas, ok := k.schema["allOf"].([]interface{}); ok {
+		for i, schema := range schemas {
+			k.r.merge(k.sub("allOf/"+strconv.Itoa(i), schema, k.instance, k.path))
+		}
+	}
+	if schemas, ok := k.schema["anyOf"].([]interface{}); ok {
+		valid := false
+		for i, schema := range schemas {
+			if sub := k.sub("anyOf/"+strconv.Itoa(i), schema, k.instance, k.path); sub.valid() {
+				valid = true
+				k.r.annotate(sub)
+			}
+		}
+		if !valid {
+			k.fail("anyOf", "value doesn't match any of the schemas")
+		}
+	}
+	if schemas, ok := k.schema["oneOf"].([]interface{}); ok {
+		matches := make([]int, 0, 1)
+		for i, schema := range schemas {
+			if sub := k.sub("oneOf/"+strconv.Itoa(i), schema, k.instance, k.path); sub.valid() {
+				matches = append(matches, i)
+				k.r.annotate(sub)
+			}
+		}
+		if len(matches) != 1 {
+			k.fail("oneOf", "value matches %d of the schemas, expected exactly one", len(matches))
+		}
+	}
+	if schema, ok := k.schema["not"]; ok {
+		if sub := k.sub("not", schema, k.instance, k.path); sub.valid() {
+			k.fail("not", "value mustn't match the schema")
+		}
+	}
+	if schema, ok := k.schema["if"]; ok {
+		if sub := k.sub("if", schema, k.instance, k.path); sub.valid() {
+			k.r.annotate(sub)
+			if then, ok := k.schema["then"]; ok {
+				k.r.merge(k.sub("then", then, k.instance, k.path))
+			}
+		} else if otherwise, ok := k.schema["else"]; ok {
+			k.r.merge(k.sub("else", otherwise, k.instance, k.path))
+		}
+	}
+}
+
+// unevaluated - unevaluatedProperties and unevaluatedItems
+// using annotations of the other keywords.
+func (k *keywords) unevaluated() {
+	if schema, ok := k.schema["unevaluatedProperties"]; ok {
+		if m, ok := k.instance.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(m))
+			for key := range m {
+				if !k.r.props[key] {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				k.r.merge(k.sub("unevaluatedProperties", schema, m[key], k.path.Append(key)))
+				k.r.evaluateProp(key)
+			}
+		}
+	}
+	if schema, ok := k.schema["unevaluatedItems"]; ok {
+		if items, ok := k.instance.([]interface{}); ok && !k.r.allItems {
+			for i, item := range items {
+				if !k.r.items[i] {
+					k.r.merge(k.sub("unevaluatedItems", schema, item, k.path.Append(strconv.Itoa(i))))
+				}
+			}
+			k.r.allItems = true
+		}
+	}
+}
+
+// hasType - check that the json value has the JSON Schema type.
+func hasType(v interface{}, name string) bool {
+	switch name {
+	case "integer":
+		n, ok := toRat(v)
+		return ok && n.IsInt()
+	case "number":
+		_, ok := toRat(v)
+		return ok
+	}
+	return typeOf(v) == name
+}
+
+// typeOf - JSON Schema type name of the json value.
+func typeOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// toRat - exact value of the json number.
+func toRat(v interface{}) (*big.Rat, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return nil, false
+	}
+	return new(big.Rat).SetString(string(n))
+}
+
+// toInt - value of the json integer number.
+func toInt(v interface{}) (int, bool) {
+	n, ok := toRat(v)
+	if !ok || !n.IsInt() || !n.Num().IsInt64() {
+		return 0, false
+	}
+	return int(n.Num().Int64()), true
+}
+
+func ratString(n *big.Rat) string {
+	if n.IsInt() {
+		return n.Num().String()
+	}
+	f, _ := n.Float64()
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// equal - JSON Schema equality of json values.
+func equal(a, b interface{}) bool {
+	return object.Equal(object.New(a), object.New(b))
+}
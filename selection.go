@@ -0,0 +1,181 @@
+package object
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// Select - project the object by GraphQL-like selection set:
+//
+//	{ user { id login: name addresses { city } } total }
+//
+// Fields are getting like with Get, "alias: name" renames the field,
+// nested sets select fields of maps and of every slice element.
+// Commas are optional, "#" starts a comment till the end of the line,
+// keys with special symbols can be double quoted.
+// Missing fields are null in the result and are reporting as their
+// paths in the source object.
+func (o Object) Select(selection string) (Object, []Path) {
+	fields, err := parseSelection(selection)
+	if err != nil {
+		return Object{nil, err}, nil
+	}
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}, nil
+	}
+	missing := make([]Path, 0)
+	return New(selectFields(o, fields, Path{}, &missing)), missing
+}
+
+// selectionField - field of the selection set.
+type selectionField struct {
+	alias  string
+	name   string
+	fields []selectionField // nil if the field has no selection set
+}
+
+// selectFields - apply the selection set to the object.
+func selectFields(o Object, fields []selectionField, path Path, missing *[]Path) interface{} {
+	switch o.kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Slice, reflect.Array:
+		if !isBytes(unwrap(*o.val)) {
+			result := make([]interface{}, 0, o.Len())
+			o.iterate(func(key string, value Object) bool {
+				result = append(result, selectFields(value, fields, path.Append(key), missing))
+				return true
+			})
+			return result
+		}
+	case reflect.Map:
+		result := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			child := o.Get(field.name)
+			childPath := path.Append(field.name)
+			switch {
+			case !child.IsExists():
+				*missing = append(*missing, childPath)
+				result[field.alias] = nil
+			case field.fields != nil:
+				result[field.alias] = selectFields(child, field.fields, childPath, missing)
+			default:
+				result[field.alias] = plain(*child.val)
+			}
+		}
+		return result
+	}
+	// nested selection of a scalar
+	*missing = append(*missing, path)
+	return nil
+}
+
+// parseSelection - parse the selection set with or without outer braces.
+func parseSelection(selection string) ([]selectionField, error) {
+	p := selectionParser{text: selection}
+	braced := p.skip() == '{'
+	if braced {
+		p.pos++
+	}
+	fields, err := p.fields()
+	if err != nil {
+		return nil, err
+	}
+	if braced {
+		if p.skip() != '}' {
+			return nil, newError(ErrorSelectionParse)
+		}
+		p.pos++
+	}
+	if p.skip() != 0 || len(fields) == 0 {
+		return nil, newError(ErrorSelectionParse)
+	}
+	return fields, nil
+}
+
+// selectionParser - recursive descent parser of selection sets.
+type selectionParser struct {
+	text string
+	pos  int
+}
+
+// skip - skip whitespaces, commas and comments, returns next byte or 0.
+func (p *selectionParser) skip() byte {
+	for p.pos < len(p.text) {
+		switch c := p.text[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.text) && p.text[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+// fields - parse fields until the closing brace or the end.
+func (p *selectionParser) fields() ([]selectionField, error) {
+	fields := make([]selectionField, 0, 4)
+	for c := p.skip(); c != '}' && c != 0; c = p.skip() {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		field := selectionField{alias: name, name: name}
+		if p.skip() == ':' {
+			p.pos++
+			p.skip()
+			if field.name, err = p.name(); err != nil {
+				return nil, err
+			}
+		}
+		if p.skip() == '{' {
+			p.pos++
+			if field.fields, err = p.fields(); err != nil {
+				return nil, err
+			}
+			if p.skip() != '}' || len(field.fields) == 0 {
+				return nil, newError(ErrorSelectionParse)
+			}
+			p.pos++
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// name - parse a bare or double quoted name.
+func (p *selectionParser) name() (string, error) {
+	start := p.pos
+	if p.pos < len(p.text) && p.text[p.pos] == '"' {
+		for p.pos++; p.pos < len(p.text) && p.text[p.pos] != '"'; p.pos++ {
+			if p.text[p.pos] == '\\' {
+				p.pos++
+			}
+		}
+		if p.pos >= len(p.text) {
+			return "", newError(ErrorSelectionParse)
+		}
+		p.pos++
+		name, err := strconv.Unquote(p.text[start:p.pos])
+		if err != nil {
+			return "", newError(ErrorSelectionParse)
+		}
+		return name, nil
+	}
+	for p.pos < len(p.text) && isNameByte(p.text[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", newError(ErrorSelectionParse)
+	}
+	return p.text[start:p.pos], nil
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || c == '-' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
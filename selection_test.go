@@ -0,0 +1,68 @@
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_Select_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{
+		"user":{"id":1,"name":"ann","password":"secret","addresses":[{"city":"Oslo","zip":"0150"},{"zip":"5003"}]},
+		"total":12,
+		"odd key":true
+	}`))
+
+	t.Run("nested selection", func(t *testing.T) {
+		result, missing := object.Select(`{ user { id name addresses { city } } total }`)
+		data, _ := result.ToCanonicalJson()
+		if string(data) != `{"total":12,"user":{"addresses":[{"city":"Oslo"},{"city":null}],"id":1,"name":"ann"}}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+		if len(missing) != 1 || missing[0].String() != "user.addresses[1].city" {
+			t.Fatalf(`expect missing user.addresses[1].city, got: %v`, missing)
+		}
+	})
+
+	t.Run("aliases, commas, comments and quotes", func(t *testing.T) {
+		result, missing := object.Select(`
+			user {
+				login: name, # renamed
+				"id"
+			}
+			flag: "odd key"
+		`)
+		data, _ := result.ToCanonicalJson()
+		if string(data) != `{"flag":true,"user":{"id":1,"login":"ann"}}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+		if len(missing) != 0 {
+			t.Fatalf(`expect no missing fields, got: %v`, missing)
+		}
+	})
+
+	t.Run("selection of a scalar", func(t *testing.T) {
+		result, missing := object.Select(`{ total { value } nothing }`)
+		data, _ := result.ToCanonicalJson()
+		if string(data) != `{"nothing":null,"total":null}` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+		if len(missing) != 2 || missing[0].String() != "total" || missing[1].String() != "nothing" {
+			t.Fatalf(`expect missing total and nothing, got: %v`, missing)
+		}
+	})
+
+	t.Run("root slice", func(t *testing.T) {
+		result, _ := object.GetPath("user.addresses").Select(`zip`)
+		if data, _ := result.ToJson(); string(data) != `[{"zip":"0150"},{"zip":"5003"}]` {
+			t.Fatalf(`unexpected result: %s`, data)
+		}
+	})
+
+	for _, selection := range []string{``, `{`, `{ user { } }`, `{ a } }`, `{ a: }`, `{ "a }`, `{ a { b }`} {
+		t.Run("invalid "+selection, func(t *testing.T) {
+			result, _ := object.Select(selection)
+			if result.GetError() == nil || result.GetError().Error() != ErrorSelectionParse {
+				t.Fatalf(`expect ErrorSelectionParse, got: %v`, result.GetError())
+			}
+		})
+	}
+}
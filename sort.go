@@ -0,0 +1,190 @@
+package object
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortKey - sorting key of SortWith.
+type SortKey struct {
+	// Path - path (ParsePath syntax) of the value to compare,
+	// empty path means the element itself.
+	Path string
+	// Descending - reverse order of the key.
+	Descending bool
+	// Compare - custom comparator returning -1, 0 or 1, Compare by default.
+	Compare func(a, b Object) int
+}
+
+// SortBy - make a new slice of the elements sorted by values at the paths
+// in ascending order. Next path is used when values at previous are equal.
+// Sorting is stable, values are comparing like in Compare.
+func (o Object) SortBy(paths ...string) Object {
+	keys := make([]SortKey, 0, len(paths))
+	for _, path := range paths {
+		keys = append(keys, SortKey{Path: path})
+	}
+	return o.SortWith(keys...)
+}
+
+// SortWith - acts like SortBy but with descending keys and custom comparators.
+// Without keys elements are sorting by themselves.
+func (o Object) SortWith(keys ...SortKey) Object {
+	elements, err := o.elements()
+	if err != nil {
+		return Object{nil, err}
+	}
+	if len(keys) == 0 {
+		keys = []SortKey{{}}
+	}
+
+	paths := make([]Path, len(keys))
+	for i, key := range keys {
+		if paths[i], err = ParsePath(key.Path); err != nil {
+			return Object{nil, err}
+		}
+	}
+
+	// values of the keys are extracting once per element
+	rows := make([][]Object, len(elements))
+	for i, element := range elements {
+		rows[i] = make([]Object, len(keys))
+		for j, path := range paths {
+			rows[i][j] = New(element).GetByPath(path)
+		}
+	}
+	order := make([]int, len(elements))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := rows[order[i]], rows[order[j]]
+		for k, key := range keys {
+			compare := key.Compare
+			if compare == nil {
+				compare = Compare
+			}
+			result := compare(a[k], b[k])
+			if key.Descending {
+				result = -result
+			}
+			if result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	})
+
+	result := make([]interface{}, len(elements))
+	for i, index := range order {
+		result[i] = elements[index]
+	}
+	return New(result)
+}
+
+// Compare - compare objects of any types, returns -1, 0 or 1.
+// Different types are ordering like in BSON: not exists and null,
+// numbers, strings, maps, slices, bytes, booleans, times, others.
+// Numbers are comparing by their values regardless of their types
+// (NaN is less than any other number and equal to itself),
+// maps - by their sorted keys and values, slices - element by element.
+func Compare(a, b Object) int {
+	var av, bv interface{}
+	if a.IsExists() {
+		av = plain(*a.val)
+	}
+	if b.IsExists() {
+		bv = plain(*b.val)
+	}
+	return comparePlain(av, bv)
+}
+
+// compareRank - order of types for Compare.
+func compareRank(v interface{}) int {
+	if _, ok := toNumber(reflect.ValueOf(v)); ok {
+		return 1
+	}
+	switch v.(type) {
+	case nil:
+		return 0
+	case string:
+		return 2
+	case map[string]interface{}:
+		return 3
+	case []interface{}:
+		return 4
+	case []byte:
+		return 5
+	case bool:
+		return 6
+	case time.Time:
+		return 7
+	}
+	if reflect.ValueOf(v).Kind() == reflect.String {
+		return 2
+	}
+	return 8
+}
+
+// comparePlain - compare plain values.
+func comparePlain(a, b interface{}) int {
+	ra, rb := compareRank(a), compareRank(b)
+	if ra != rb {
+		return compareInt64(int64(ra), int64(rb))
+	}
+
+	switch ra {
+	case 0:
+		return 0
+	case 1:
+		na, _ := toNumber(reflect.ValueOf(a))
+		nb, _ := toNumber(reflect.ValueOf(b))
+		return na.compare(nb)
+	case 2:
+		return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+	case 3:
+		am, bm := a.(map[string]interface{}), b.(map[string]interface{})
+		ak, bk := sortedKeys(am), sortedKeys(bm)
+		for i := 0; i < len(ak) && i < len(bk); i++ {
+			if result := strings.Compare(ak[i], bk[i]); result != 0 {
+				return result
+			}
+			if result := comparePlain(am[ak[i]], bm[bk[i]]); result != 0 {
+				return result
+			}
+		}
+		return compareInt64(int64(len(ak)), int64(len(bk)))
+	case 4:
+		as, bs := a.([]interface{}), b.([]interface{})
+		for i := 0; i < len(as) && i < len(bs); i++ {
+			if result := comparePlain(as[i], bs[i]); result != 0 {
+				return result
+			}
+		}
+		return compareInt64(int64(len(as)), int64(len(bs)))
+	case 5:
+		return bytes.Compare(a.([]byte), b.([]byte))
+	case 6:
+		ab, bb := a.(bool), b.(bool)
+		if ab == bb {
+			return 0
+		} else if bb {
+			return -1
+		}
+		return 1
+	case 7:
+		at, bt := a.(time.Time), b.(time.Time)
+		if at.Before(bt) {
+			return -1
+		} else if at.After(bt) {
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
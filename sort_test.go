@@ -0,0 +1,106 @@
+package object
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestObject_SortBy_Json(t *testing.T) {
+	object := NewFromJson([]byte(`[
+		{"name":"b","age":30,"tag":"x"},
+		{"name":"a","age":25,"tag":"y"},
+		{"name":"c","age":30,"tag":"z"},
+		{"name":"d","tag":"w"},
+		{"name":"e","age":null,"tag":"v"},
+		{"name":"f","age":"unknown","tag":"u"}
+	]`))
+
+	names := func(obj Object) string {
+		result := ""
+		obj.ForEach(func(entry Entry) {
+			result += entry.Value.Get("name").ToValue().(string)
+		})
+		return result
+	}
+
+	t.Run("single path", func(t *testing.T) {
+		if result := names(object.SortBy("age")); result != "deabcf" {
+			t.Fatalf(`expect deabcf, got: %s`, result)
+		}
+	})
+
+	t.Run("multiple paths", func(t *testing.T) {
+		if result := names(object.SortBy("age", "tag")); result != "edabcf" {
+			t.Fatalf(`expect edabcf, got: %s`, result)
+		}
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		result := names(object.SortWith(SortKey{Path: "age", Descending: true}, SortKey{Path: "name"}))
+		if result != "fbcade" {
+			t.Fatalf(`expect fbcade, got: %s`, result)
+		}
+	})
+
+	t.Run("custom comparator", func(t *testing.T) {
+		byLength := func(a, b Object) int {
+			return compareInt64(int64(a.Len()), int64(b.Len()))
+		}
+		result := names(object.SortWith(SortKey{Path: "age", Compare: byLength}))
+		if result != "bacdef" {
+			t.Fatalf(`expect stable source order except f, got: %s`, result)
+		}
+	})
+
+	t.Run("mixed types", func(t *testing.T) {
+		mixed := New([]interface{}{true, "s", map[string]interface{}{}, nil, 2, []interface{}{1}, -1.5, uint8(3)})
+		data, _ := mixed.SortWith().ToJson()
+		if string(data) != `[null,-1.5,2,3,"s",{},[1],true]` {
+			t.Fatalf(`unexpected order: %s`, data)
+		}
+	})
+
+	t.Run("nan", func(t *testing.T) {
+		nan := math.NaN()
+		result := fmt.Sprint(New([]interface{}{3.0, nan, 1.0, nan, 2.0}).SortBy().ToValue())
+		if result != "[NaN NaN 1 2 3]" {
+			t.Fatalf(`expect [NaN NaN 1 2 3], got: %s`, result)
+		}
+	})
+
+	t.Run("bad path", func(t *testing.T) {
+		if obj := object.SortBy("a..b"); obj.GetError().Error() != ErrorPathParse {
+			t.Fatalf(`expect ErrorPathParse, got: %v`, obj.GetError())
+		}
+	})
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b   Object
+		result int
+	}{
+		{New(1), New(1.0), 0},
+		{New(uint64(1 << 63)), New(int64(-1)), 1},
+		{New("a"), New("b"), -1},
+		{New(nil), Object{}, 0},
+		{New(map[string]interface{}{"a": 1}), New(map[string]interface{}{"a": 2}), -1},
+		{New([]interface{}{1, 2}), New([]interface{}{1}), 1},
+		{New(false), New(true), -1},
+		{New(math.NaN()), New(math.NaN()), 0},
+		{New(math.NaN()), New(math.Inf(-1)), -1},
+		{New(1), New(math.NaN()), 1},
+		{New(int64(9007199254740993)), New(9007199254740992.0), 1},
+		{New(9007199254740992.0), New(int64(9007199254740993)), -1},
+		{New(-1.5), New(int64(-1)), -1},
+		{New(1.5), New(uint64(1)), 1},
+		{New(math.Inf(1)), New(uint64(1<<64 - 1)), 1},
+		{New(math.Inf(-1)), New(int64(-1 << 63)), -1},
+	}
+	for i, c := range cases {
+		if result := Compare(c.a, c.b); result != c.result {
+			t.Fatalf(`case %d: expect %d, got: %d`, i, c.result, result)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Package structgen generates Go struct definitions from sample objects,
+// so partially known documents can be turned into typed structures:
+//
+//	source, err := structgen.Generate(object.NewFromData(a), object.NewFromData(b))
+//
+// Samples are merging: fields absent in some samples or null in some
+// samples become pointers, numbers are int64 if all observed values are
+// integer and float64 otherwise, values of different types are interface{}.
+package structgen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/the-go-tool/object"
+	"github.com/the-go-tool/object/schema"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Options - options of the generation.
+type Options struct {
+	// Package - name of the package clause, it's omitting if empty.
+	Package string
+	// Name - name of the root type, "Root" by default.
+	Name string
+	// Tags - names of struct tags, json, yaml and toml by default.
+	Tags []string
+}
+
+func (o Options) name() string {
+	if o.Name == "" {
+		return "Root"
+	}
+	return o.Name
+}
+
+func (o Options) tags() []string {
+	if o.Tags == nil {
+		return []string{"json", "yaml", "toml"}
+	}
+	return o.Tags
+}
+
+// Generate - generate Go source of types describing all the samples.
+func Generate(samples ...object.Object) ([]byte, error) {
+	return GenerateWith(Options{}, samples...)
+}
+
+// GenerateWith - acts like Generate but with options.
+func GenerateWith(options Options, samples ...object.Object) ([]byte, error) {
+	inferred, err := schema.InferWith(schema.InferOptions{EnumLimit: -1}, samples...)
+	if err != nil {
+		return nil, err
+	}
+	root, _ := inferred.ToValue().(map[string]interface{})
+
+	g := generator{options: options, names: make(map[string]bool)}
+	g.names[options.name()] = true
+	g.queue = append(g.queue, definition{name: options.name(), schema: root})
+	for len(g.queue) > 0 {
+		def := g.queue[0]
+		g.queue = g.queue[1:]
+		g.define(def)
+	}
+
+	var source bytes.Buffer
+	if options.Package != "" {
+		fmt.Fprintf(&source, "package %s\n\n", options.Package)
+	}
+	source.Write(g.out.Bytes())
+	return format.Source(source.Bytes())
+}
+
+// definition - named type waiting to be generated.
+type definition struct {
+	name   string
+	schema map[string]interface{}
+}
+
+type generator struct {
+	options Options
+	names   map[string]bool
+	queue   []definition
+	out     bytes.Buffer
+}
+
+// define - write the type definition.
+func (g *generator) define(def definition) {
+	if len(g.out.Bytes()) > 0 {
+		g.out.WriteString("\n")
+	}
+	properties, ok := def.schema["properties"].(map[string]interface{})
+	if !ok || typeName(def.schema) != "object" {
+		fmt.Fprintf(&g.out, "type %s %s\n", def.name, g.goType(def.name, def.schema, true))
+		return
+	}
+
+	required := make(map[string]bool)
+	list, _ := def.schema["required"].([]interface{})
+	for _, key := range list {
+		required[key.(string)] = true
+	}
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(&g.out, "type %s struct {\n", def.name)
+	fields := make(map[string]bool)
+	for _, key := range keys {
+		field := unique(fields, identifier(key))
+		prop, _ := properties[key].(map[string]interface{})
+		fmt.Fprintf(&g.out, "\t%s %s %s\n", field, g.goType(field, prop, required[key]), g.tag(key, required[key]))
+	}
+	g.out.WriteString("}\n")
+}
+
+// goType - Go type of the schema, nested structures are queuing
+// to be defined with the name.
+func (g *generator) goType(name string, s map[string]interface{}, required bool) string {
+	nullable := false
+	var t string
+	switch types := s["type"].(type) {
+	case string:
+		t = types
+	case []interface{}:
+		if len(types) == 2 && types[1] == "null" {
+			t, nullable = types[0].(string), true
+		}
+	}
+
+	var result string
+	switch t {
+	case "boolean":
+		result = "bool"
+	case "integer":
+		result = "int64"
+	case "number":
+		result = "float64"
+	case "string":
+		result = "string"
+	case "array":
+		items, _ := s["items"].(map[string]interface{})
+		return "[]" + g.goType(name+"Item", items, true)
+	case "object":
+		if _, ok := s["properties"].(map[string]interface{}); !ok {
+			return "map[string]interface{}"
+		}
+		result = unique(g.names, name)
+		g.queue = append(g.queue, definition{name: result, schema: s})
+	default:
+		return "interface{}"
+	}
+	if nullable || !required {
+		return "*" + result
+	}
+	return result
+}
+
+// tag - struct tag of the field.
+func (g *generator) tag(key string, required bool) string {
+	if !required {
+		key += ",omitempty"
+	}
+	parts := make([]string, 0, len(g.options.tags()))
+	for _, tag := range g.options.tags() {
+		parts = append(parts, tag+":"+strconv.Quote(key))
+	}
+	tag := strings.Join(parts, " ")
+	if strings.Contains(tag, "`") {
+		return strconv.Quote(tag)
+	}
+	return "`" + tag + "`"
+}
+
+// typeName - single type of the schema.
+func typeName(s map[string]interface{}) string {
+	switch types := s["type"].(type) {
+	case string:
+		return types
+	case []interface{}:
+		if len(types) == 2 && types[1] == "null" {
+			return types[0].(string)
+		}
+	}
+	return ""
+}
+
+// initialisms - words written in upper case in Go identifiers.
+var initialisms = map[string]bool{
+	"API": true, "ID": true, "HTML": true, "HTTP": true, "HTTPS": true, "IP": true,
+	"JSON": true, "SQL": true, "TLS": true, "TOML": true, "URI": true, "URL": true, "UUID": true, "YAML": true,
+}
+
+// identifier - exported Go identifier of the key, like "UserID" for "user_id".
+func identifier(key string) string {
+	words := make([]string, 0)
+	word := make([]rune, 0, len(key))
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+	runes := []rune(key)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			flush()
+		}
+		word = append(word, r)
+	}
+	flush()
+
+	var result strings.Builder
+	for _, w := range words {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			result.WriteString(upper)
+			continue
+		}
+		r := []rune(w)
+		result.WriteString(strings.ToUpper(string(r[0])) + string(r[1:]))
+	}
+	name := result.String()
+	if name == "" {
+		return "Field"
+	}
+	// digits and letters without upper case (like CJK) aren't exported
+	if !unicode.IsUpper([]rune(name)[0]) {
+		return "X" + name
+	}
+	return name
+}
+
+// unique - register the name, numbering it if it's already taken.
+func unique(names map[string]bool, name string) string {
+	result := name
+	for i := 2; names[result]; i++ {
+		result = name + strconv.Itoa(i)
+	}
+	names[result] = true
+	return result
+}
@@ -0,0 +1,83 @@
+package structgen
+
+import (
+	"github.com/the-go-tool/object"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("merged samples", func(t *testing.T) {
+		source, err := GenerateWith(Options{Package: "config", Name: "Config"},
+			object.NewFromData([]byte("name: app\nport: 80\nratio: 1\nserver: {host: a, tls: true}\nlabels: [a]\n")),
+			object.NewFromData([]byte(`{"name":"api","port":8080,"ratio":0.5,"server":null,"debug":true}`)),
+			object.NewFromToml([]byte("name = \"db\"\nport = 5432\nratio = 2\n\n[server]\nhost = \"b\"\n")),
+		)
+		if err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		expected := "package config\n\n" +
+			"type Config struct {\n" +
+			"\tDebug  *bool    `json:\"debug,omitempty\" yaml:\"debug,omitempty\" toml:\"debug,omitempty\"`\n" +
+			"\tLabels []string `json:\"labels,omitempty\" yaml:\"labels,omitempty\" toml:\"labels,omitempty\"`\n" +
+			"\tName   string   `json:\"name\" yaml:\"name\" toml:\"name\"`\n" +
+			"\tPort   int64    `json:\"port\" yaml:\"port\" toml:\"port\"`\n" +
+			"\tRatio  float64  `json:\"ratio\" yaml:\"ratio\" toml:\"ratio\"`\n" +
+			"\tServer *Server  `json:\"server\" yaml:\"server\" toml:\"server\"`\n" +
+			"}\n\n" +
+			"type Server struct {\n" +
+			"\tHost string `json:\"host\" yaml:\"host\" toml:\"host\"`\n" +
+			"\tTLS  *bool  `json:\"tls,omitempty\" yaml:\"tls,omitempty\" toml:\"tls,omitempty\"`\n" +
+			"}\n"
+		if string(source) != expected {
+			t.Fatalf(`unexpected source: %s`, source)
+		}
+	})
+	t.Run("root slice", func(t *testing.T) {
+		source, err := GenerateWith(Options{Tags: []string{"json"}},
+			object.NewFromJson([]byte(`[{"id":1,"value":"a"},{"id":2,"value":3}]`)))
+		if err != nil {
+			t.Fatalf(`unexpected error: %v`, err)
+		}
+		expected := "type Root []RootItem\n\n" +
+			"type RootItem struct {\n" +
+			"\tID    int64       `json:\"id\"`\n" +
+			"\tValue interface{} `json:\"value\"`\n" +
+			"}\n"
+		if string(source) != expected {
+			t.Fatalf(`unexpected source: %s`, source)
+		}
+	})
+	t.Run("invalid sample", func(t *testing.T) {
+		if _, err := Generate(object.NewFromJson([]byte(`{`))); err == nil {
+			t.Fatalf(`expected error`)
+		}
+	})
+}
+
+func TestIdentifier(t *testing.T) {
+	cases := map[string]string{
+		"user_id":    "UserID",
+		"userName":   "UserName",
+		"api-url":    "APIURL",
+		"2fa":        "X2fa",
+		"---":        "Field",
+		"Ünïcode ok": "ÜnïcodeOk",
+		"名字":         "X名字",
+		"名字_ok":      "X名字Ok",
+	}
+	for key, expected := range cases {
+		if result := identifier(key); result != expected {
+			t.Fatalf(`unexpected identifier of %q: %s`, key, result)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	names := map[string]bool{"Name": true}
+	if result := unique(names, "Name"); result != "Name2" {
+		t.Fatalf(`unexpected name: %s`, result)
+	}
+	if result := unique(names, "Name"); result != "Name3" {
+		t.Fatalf(`unexpected name: %s`, result)
+	}
+}
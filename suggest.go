@@ -0,0 +1,90 @@
+package object
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Suggest - the closest to the word candidates, like "did you mean" hints.
+// Case-insensitive matches go first, then matches by edit distance
+// (with transpositions) up to a third of the word length (at least 1 edit),
+// closer and then alphabetically lesser candidates first.
+func Suggest(word string, candidates []string) []string {
+	type match struct {
+		candidate string
+		distance  int
+	}
+	lower := strings.ToLower(word)
+	limit := utf8.RuneCountInString(word) / 3
+	if limit < 1 {
+		limit = 1
+	}
+	matches := make([]match, 0)
+	for _, candidate := range candidates {
+		if candidate == word {
+			continue
+		}
+		distance := editDistance(lower, strings.ToLower(candidate))
+		if distance <= limit {
+			matches = append(matches, match{candidate, distance})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].candidate < matches[j].candidate
+	})
+	result := make([]string, len(matches))
+	for i, m := range matches {
+		result[i] = m.candidate
+	}
+	return result
+}
+
+// QuoteJoin - join quoted strings with the separator,
+// like suggestions of the "did you mean" hints.
+func QuoteJoin(items []string, separator string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = strconv.Quote(item)
+	}
+	return strings.Join(quoted, separator)
+}
+
+// editDistance - edit distance of the strings in runes, where edits are
+// insertions, deletions, substitutions and transpositions of adjacent runes
+// (optimal string alignment distance).
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	d := make([][]int, len(ar)+1)
+	for i := range d {
+		d[i] = make([]int, len(br)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(minInt(d[i-1][j]+1, d[i][j-1]+1), d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ar[i-1] == br[j-2] && ar[i-2] == br[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ar)][len(br)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,46 @@
+package object
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"username", "userId", "name", "port", "host", "posts"}
+	cases := map[string]string{
+		"userName": "username",
+		"prot":     "port",
+		"post":     "host,port,posts",
+		"useid":    "userId",
+		"x":        "",
+		"name":     "",
+	}
+	for word, expected := range cases {
+		if result := strings.Join(Suggest(word, candidates), ","); result != expected {
+			t.Fatalf(`unexpected suggestions for %q: %s`, word, result)
+		}
+	}
+}
+
+func TestQuoteJoin(t *testing.T) {
+	if result := QuoteJoin([]string{"a", `b"c`}, " or "); result != `"a" or "b\"c"` {
+		t.Fatalf(`unexpected result: %s`, result)
+	}
+	if result := QuoteJoin(nil, ", "); result != "" {
+		t.Fatalf(`expect empty result, got: %s`, result)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := map[[2]string]int{
+		{"", ""}:              0,
+		{"kitten", "sitting"}: 3,
+		{"ключ", "клюв"}:      1,
+		{"abc", ""}:           3,
+	}
+	for pair, expected := range cases {
+		if result := editDistance(pair[0], pair[1]); result != expected {
+			t.Fatalf(`unexpected distance of %q and %q: %d`, pair[0], pair[1], result)
+		}
+	}
+}
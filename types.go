@@ -0,0 +1,126 @@
+package object
+
+import (
+	"gopkg.in/mgo.v2/bson"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Type - normalized type of the object's value. It's same for same data
+// regardless of the format it came from (like JSON float64, YAML int
+// or TOML int64) or Go types.
+type Type int
+
+const (
+	// TypeMissing - the object isn't exists.
+	TypeMissing Type = iota
+	// TypeNull - nil, null, nil pointer, nil map or nil slice.
+	TypeNull
+	// TypeBool - boolean.
+	TypeBool
+	// TypeInteger - integer number of any type, including floats
+	// without fractional part (JSON has only floats) and json.Number.
+	TypeInteger
+	// TypeFloat - number with fractional part, infinity, NaN or bson.Decimal128.
+	TypeFloat
+	// TypeString - string of any type, like bson.ObjectId.
+	TypeString
+	// TypeBytes - byte slice or array, bson.Binary.
+	TypeBytes
+	// TypeTime - time.Time (like TOML and BSON datetimes).
+	TypeTime
+	// TypeArray - slice or array.
+	TypeArray
+	// TypeMap - map or bson.D.
+	TypeMap
+	// TypeOther - values without data representation like channels,
+	// functions and complex numbers, also structs (their fields
+	// aren't accessible by Get, Len, ForEach and others).
+	TypeOther
+)
+
+var typeNames = map[Type]string{
+	TypeMissing: "missing",
+	TypeNull:    "null",
+	TypeBool:    "bool",
+	TypeInteger: "integer",
+	TypeFloat:   "float",
+	TypeString:  "string",
+	TypeBytes:   "bytes",
+	TypeTime:    "time",
+	TypeArray:   "array",
+	TypeMap:     "map",
+	TypeOther:   "other",
+}
+
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+var (
+	typeTime         = reflect.TypeOf(time.Time{})
+	typeBsonBinary   = reflect.TypeOf(bson.Binary{})
+	typeBsonDecimal  = reflect.TypeOf(bson.Decimal128{})
+	typeBsonObjectId = reflect.TypeOf(bson.ObjectId(""))
+)
+
+// Type - normalized type of the object's value.
+func (o Object) Type() Type {
+	if !o.IsExists() {
+		return TypeMissing
+	}
+	v := *o.val
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return TypeNull
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return TypeNull
+	}
+
+	switch v.Type() {
+	case typeTime:
+		return TypeTime
+	case typeBsonBinary:
+		return TypeBytes
+	case typeBsonDecimal:
+		return TypeFloat
+	case typeBsonObjectId:
+		return TypeString
+	case typeBsonD:
+		return TypeMap
+	}
+	if n, ok := toNumber(v); ok {
+		if n.kind != reflect.Float64 || n.float == math.Trunc(n.float) && !math.IsInf(n.float, 0) {
+			return TypeInteger
+		}
+		return TypeFloat
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return TypeBool
+	case reflect.String:
+		return TypeString
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return TypeNull
+		}
+		if isBytes(v) {
+			return TypeBytes
+		}
+		return TypeArray
+	case reflect.Map:
+		if v.IsNil() {
+			return TypeNull
+		}
+		return TypeMap
+	}
+	return TypeOther
+}
@@ -0,0 +1,67 @@
+package object
+
+import (
+	"encoding/json"
+	"gopkg.in/mgo.v2/bson"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestObject_Type(t *testing.T) {
+	type point struct{ X, Y int }
+	var nilPointer *int
+	var nilMap map[string]int
+	one := 1
+
+	cases := []struct {
+		name     string
+		object   Object
+		expected Type
+	}{
+		{"missing", New(map[string]int{}).Get("a"), TypeMissing},
+		{"zero object", Object{}, TypeMissing},
+		{"nil", New(nil), TypeNull},
+		{"nil pointer", New(nilPointer), TypeNull},
+		{"nil map", New(nilMap), TypeNull},
+		{"json null", NewFromJson([]byte(`{"a":null}`)).Get("a"), TypeNull},
+		{"bool", New(true), TypeBool},
+		{"json integer", NewFromJson([]byte(`{"a":5}`)).Get("a"), TypeInteger},
+		{"yaml integer", NewFromYaml([]byte(`a: 5`)).Get("a"), TypeInteger},
+		{"toml integer", NewFromToml([]byte(`a = 5`)).Get("a"), TypeInteger},
+		{"uint64", New(uint64(math.MaxUint64)), TypeInteger},
+		{"pointer to int", New(&one), TypeInteger},
+		{"json.Number", New(json.Number("7")), TypeInteger},
+		{"json float", NewFromJson([]byte(`{"a":5.5}`)).Get("a"), TypeFloat},
+		{"yaml float", NewFromYaml([]byte(`a: 5.5`)).Get("a"), TypeFloat},
+		{"json.Number float", New(json.Number("7.5")), TypeFloat},
+		{"infinity", New(math.Inf(1)), TypeFloat},
+		{"nan", New(math.NaN()), TypeFloat},
+		{"decimal128", New(bson.Decimal128{}), TypeFloat},
+		{"string", New("a"), TypeString},
+		{"object id", New(bson.NewObjectId()), TypeString},
+		{"bytes", New([]byte("a")), TypeBytes},
+		{"bson binary", New(bson.Binary{Kind: 0, Data: []byte("a")}), TypeBytes},
+		{"time", New(time.Now()), TypeTime},
+		{"toml time", NewFromToml([]byte(`a = 1979-05-27T07:32:00Z`)).Get("a"), TypeTime},
+		{"slice", NewFromJson([]byte(`[1]`)), TypeArray},
+		{"array", New([2]int{}), TypeArray},
+		{"map", NewFromYaml([]byte(`a: 1`)), TypeMap},
+		{"bson.D", New(bson.D{{Name: "a", Value: 1}}), TypeMap},
+		{"struct", New(point{}), TypeOther},
+		{"func", New(func() {}), TypeOther},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if result := c.object.Type(); result != c.expected {
+				t.Fatalf(`expect %s, got: %s`, c.expected, result)
+			}
+		})
+	}
+
+	t.Run("names", func(t *testing.T) {
+		if TypeInteger.String() != "integer" || Type(100).String() != "unknown" {
+			t.Fatalf(`unexpected names: %s, %s`, TypeInteger, Type(100))
+		}
+	})
+}
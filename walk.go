@@ -0,0 +1,101 @@
+package object
+
+// WalkAction - visitor's decision how to continue the walking.
+type WalkAction int
+
+const (
+	// WalkContinue - continue with children of the current object.
+	WalkContinue WalkAction = iota
+	// WalkSkip - don't visit children of the current object.
+	WalkSkip
+	// WalkStop - stop the walking at all.
+	WalkStop
+)
+
+// WalkOrder - order of objects visiting.
+type WalkOrder int
+
+const (
+	// WalkDepthFirst - visit an object, then all its descendants,
+	// and only then its siblings.
+	WalkDepthFirst WalkOrder = iota
+	// WalkBreadthFirst - visit all objects of a depth level
+	// before the next level.
+	WalkBreadthFirst
+)
+
+// WalkOptions - options of the objects tree walking.
+type WalkOptions struct {
+	// Order - depth-first (by default) or breadth-first.
+	Order WalkOrder
+	// MaxDepth - don't visit objects deeper than the depth if positive.
+	// The object itself has zero depth, its children - 1 and so on.
+	MaxDepth int
+}
+
+// Walk - visit the object and all its descendants depth-first.
+// The path is relative to the object, so depth is len(path).
+// Map entries are visiting in ascending keys order.
+// Returns the object itself to continue the chain.
+func (o Object) Walk(fn func(path Path, value Object) WalkAction) Object {
+	return o.WalkWith(WalkOptions{}, fn)
+}
+
+// WalkWith - acts like Walk but with walking options.
+func (o Object) WalkWith(options WalkOptions, fn func(path Path, value Object) WalkAction) Object {
+	if !o.IsExists() {
+		return Object{nil, newError(ErrorObjectNotExists)}
+	}
+	if options.Order == WalkBreadthFirst {
+		o.walkBreadth(options, fn)
+	} else {
+		o.walkDepth(Path{}, options, fn)
+	}
+	return o
+}
+
+// walkDepth - depth-first walking, returns false if it was stopped.
+func (o Object) walkDepth(path Path, options WalkOptions, fn func(Path, Object) WalkAction) bool {
+	switch fn(path, o) {
+	case WalkStop:
+		return false
+	case WalkSkip:
+		return true
+	}
+	if options.MaxDepth > 0 && len(path) >= options.MaxDepth {
+		return true
+	}
+	entries, _ := o.entries()
+	for _, entry := range entries {
+		if !entry.Value.walkDepth(path.Append(entry.Key), options, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// walkBreadth - breadth-first walking.
+func (o Object) walkBreadth(options WalkOptions, fn func(Path, Object) WalkAction) {
+	type node struct {
+		path  Path
+		value Object
+	}
+	queue := []node{{Path{}, o}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		switch fn(current.path, current.value) {
+		case WalkStop:
+			return
+		case WalkSkip:
+			continue
+		}
+		if options.MaxDepth > 0 && len(current.path) >= options.MaxDepth {
+			continue
+		}
+		entries, _ := current.value.entries()
+		for _, entry := range entries {
+			queue = append(queue, node{current.path.Append(entry.Key), entry.Value})
+		}
+	}
+}
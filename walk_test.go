@@ -0,0 +1,78 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObject_Walk_Json(t *testing.T) {
+	object := NewFromJson([]byte(`{"a":{"b":"c","d":[1,2]},"e":[3]}`))
+
+	walk := func(options WalkOptions, action func(path Path) WalkAction) []string {
+		paths := make([]string, 0, 8)
+		object.WalkWith(options, func(path Path, value Object) WalkAction {
+			paths = append(paths, path.String())
+			return action(path)
+		})
+		return paths
+	}
+	always := func(Path) WalkAction { return WalkContinue }
+
+	t.Run("depth first", func(t *testing.T) {
+		paths := walk(WalkOptions{}, always)
+		control := []string{"", "a", "a.b", "a.d", "a.d[0]", "a.d[1]", "e", "e[0]"}
+		if !reflect.DeepEqual(paths, control) {
+			t.Fatalf(`expect %v, got: %v`, control, paths)
+		}
+	})
+
+	t.Run("breadth first", func(t *testing.T) {
+		paths := walk(WalkOptions{Order: WalkBreadthFirst}, always)
+		control := []string{"", "a", "e", "a.b", "a.d", "e[0]", "a.d[0]", "a.d[1]"}
+		if !reflect.DeepEqual(paths, control) {
+			t.Fatalf(`expect %v, got: %v`, control, paths)
+		}
+	})
+
+	t.Run("skip subtree", func(t *testing.T) {
+		paths := walk(WalkOptions{}, func(path Path) WalkAction {
+			if path.String() == "a" {
+				return WalkSkip
+			}
+			return WalkContinue
+		})
+		control := []string{"", "a", "e", "e[0]"}
+		if !reflect.DeepEqual(paths, control) {
+			t.Fatalf(`expect %v, got: %v`, control, paths)
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		for _, order := range []WalkOrder{WalkDepthFirst, WalkBreadthFirst} {
+			paths := walk(WalkOptions{Order: order}, func(path Path) WalkAction {
+				if path.String() == "a.d" {
+					return WalkStop
+				}
+				return WalkContinue
+			})
+			if paths[len(paths)-1] != "a.d" {
+				t.Fatalf(`expect a.d last, got: %v`, paths)
+			}
+		}
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		paths := walk(WalkOptions{MaxDepth: 1}, always)
+		control := []string{"", "a", "e"}
+		if !reflect.DeepEqual(paths, control) {
+			t.Fatalf(`expect %v, got: %v`, control, paths)
+		}
+	})
+
+	t.Run("not exists", func(t *testing.T) {
+		obj := object.Get("x").Walk(func(Path, Object) WalkAction { return WalkContinue })
+		if obj.GetError().Error() != ErrorObjectNotExists {
+			t.Fatalf(`expect ErrorObjectNotExists, got: %v`, obj.GetError())
+		}
+	})
+}